@@ -0,0 +1,145 @@
+// main.go
+
+// Command recipes exports every recipe to a CSV or JSONL file, or imports
+// one, for migrating recipes in batches.
+//
+//	recipes export [-format csv|jsonl] [-o file]
+//	recipes import [-format csv|jsonl] [-dry-run] [-author user-id] file
+//
+// The format defaults to the extension of the file, and to CSV for standard
+// output. Imported rows without an authorId are credited to -author.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/klins/devpool/go-day6/wongnok/config"
+	"github.com/klins/devpool/go-day6/wongnok/internal/bulk"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: recipes export [-format csv|jsonl] [-o file]")
+	fmt.Fprintln(os.Stderr, "       recipes import [-format csv|jsonl] [-dry-run] [-author user-id] file")
+	os.Exit(2)
+}
+
+// format picks the format given, or the one of the file extension.
+func format(name string, path string) bulk.Format {
+	if name == "" {
+		name = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+
+	switch bulk.Format(name) {
+	case bulk.CSV, "":
+		return bulk.CSV
+	case bulk.JSONL:
+		return bulk.JSONL
+	}
+
+	log.Fatalf("Unknown format %q", name)
+	return ""
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	// Load configuration
+	var conf config.Config
+
+	if err := env.Parse(&conf); err != nil {
+		log.Fatal("Error when decoding configuration:", err)
+	}
+
+	// Database connection
+	db, err := gorm.Open(postgres.Open(conf.Database.URL), &gorm.Config{})
+	if err != nil {
+		log.Fatal("Error when connect to database:", err)
+	}
+	// Ensure close connection when terminated
+	defer func() {
+		sqldb, _ := db.DB()
+		sqldb.Close()
+	}()
+
+	service := bulk.NewService(db)
+
+	switch os.Args[1] {
+	case "export":
+		flags := flag.NewFlagSet("export", flag.ExitOnError)
+		formatName := flags.String("format", "", "csv or jsonl")
+		output := flags.String("o", "", "file to write, standard output when empty")
+		flags.Parse(os.Args[2:])
+
+		var w io.Writer = os.Stdout
+		if *output != "" {
+			file, err := os.Create(*output)
+			if err != nil {
+				log.Fatal("Error when create file:", err)
+			}
+			defer file.Close()
+			w = file
+		}
+
+		count, err := service.Export(format(*formatName, *output), w)
+		if err != nil {
+			log.Fatalf("Exported %d recipes before failing: %v", count, err)
+		}
+
+		log.Printf("Exported %d recipes", count)
+
+	case "import":
+		flags := flag.NewFlagSet("import", flag.ExitOnError)
+		formatName := flags.String("format", "", "csv or jsonl")
+		dryRun := flags.Bool("dry-run", false, "check every row and roll back")
+		author := flags.String("author", "", "user ID credited for rows without an authorId")
+		flags.Parse(os.Args[2:])
+
+		if flags.NArg() != 1 {
+			usage()
+		}
+
+		file, err := os.Open(flags.Arg(0))
+		if err != nil {
+			log.Fatal("Error when open file:", err)
+		}
+		defer file.Close()
+
+		report, err := service.Import(format(*formatName, flags.Arg(0)), file, *dryRun, model.Claims{ID: *author})
+		if err != nil {
+			log.Fatal("Error when import recipes:", err)
+		}
+
+		for _, rowError := range report.Errors {
+			log.Printf("Row %d %q: %s", rowError.Row, rowError.Name, rowError.Message)
+		}
+
+		switch {
+		case report.Committed:
+			log.Printf("Imported %d of %d recipes", report.Imported, report.Rows)
+		case report.DryRun && len(report.Errors) == 0:
+			log.Printf("Dry run: all %d recipes would be imported", report.Rows)
+		default:
+			log.Printf("Nothing imported: %d of %d recipes failed", len(report.Errors), report.Rows)
+			// Deferred calls do not run on exit, close the connection first
+			sqldb, _ := db.DB()
+			sqldb.Close()
+			os.Exit(1)
+		}
+
+	default:
+		usage()
+	}
+}
@@ -0,0 +1,52 @@
+// main.go
+
+// Command relabel derives the dietary labels, allergens and search columns of
+// every recipe, and the words of every pantry item, again. Run it after
+// changing internal/dietary/rules.csv or internal/search/words.txt.
+package main
+
+import (
+	"log"
+
+	"github.com/caarlos0/env/v11"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/klins/devpool/go-day6/wongnok/config"
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pantry"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func main() {
+	// Load configuration
+	var conf config.Config
+
+	if err := env.Parse(&conf); err != nil {
+		log.Fatal("Error when decoding configuration:", err)
+	}
+
+	// Database connection
+	db, err := gorm.Open(postgres.Open(conf.Database.URL), &gorm.Config{})
+	if err != nil {
+		log.Fatal("Error when connect to database:", err)
+	}
+	// Ensure close connection when terminated
+	defer func() {
+		sqldb, _ := db.DB()
+		sqldb.Close()
+	}()
+
+	count, err := foodrecipe.NewService(db).Relabel()
+	if err != nil {
+		log.Fatalf("Relabelled %d recipes before failing: %v", count, err)
+	}
+
+	log.Printf("Relabelled %d recipes", count)
+
+	count, err = pantry.NewService(db).Relabel()
+	if err != nil {
+		log.Fatalf("Relabelled %d pantry items before failing: %v", count, err)
+	}
+
+	log.Printf("Relabelled %d pantry items", count)
+}
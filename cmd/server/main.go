@@ -17,9 +17,19 @@ import (
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/klins/devpool/go-day6/wongnok/config"
 	"github.com/klins/devpool/go-day6/wongnok/internal/auth"
+	"github.com/klins/devpool/go-day6/wongnok/internal/bulk"
+	"github.com/klins/devpool/go-day6/wongnok/internal/collection"
+	"github.com/klins/devpool/go-day6/wongnok/internal/comment"
 	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/mealplan"
 	"github.com/klins/devpool/go-day6/wongnok/internal/middleware"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pantry"
 	"github.com/klins/devpool/go-day6/wongnok/internal/rating"
+	"github.com/klins/devpool/go-day6/wongnok/internal/shoppinglist"
+	"github.com/klins/devpool/go-day6/wongnok/internal/storage"
+	"github.com/klins/devpool/go-day6/wongnok/internal/tag"
+	"github.com/klins/devpool/go-day6/wongnok/internal/upload"
 	"github.com/klins/devpool/go-day6/wongnok/internal/user"
 	"golang.org/x/oauth2"
 	"gorm.io/driver/postgres"
@@ -76,6 +86,12 @@ func main() {
 	}
 	verifierSkipClientCheck := provider.Verifier(&oidc.Config{SkipClientIDCheck: true})
 
+	// Storage of uploaded images
+	store, err := storage.New(conf.Storage)
+	if err != nil {
+		log.Fatal("Error when open storage:", err)
+	}
+
 	// Handler
 	foodRecipeHandler := foodrecipe.NewHandler(db)
 	ratingHandler := rating.NewHandler(db)
@@ -96,6 +112,14 @@ func main() {
 		provider.Verifier(&oidc.Config{ClientID: conf.Keycloak.ClientID}),
 	)
 	userHandler := user.NewHandler(db)
+	tagHandler := tag.NewHandler(db)
+	collectionHandler := collection.NewHandler(db)
+	mealPlanHandler := mealplan.NewHandler(db)
+	shoppingListHandler := shoppinglist.NewHandler(db)
+	pantryHandler := pantry.NewHandler(db)
+	bulkHandler := bulk.NewHandler(db)
+	uploadHandler := upload.NewHandler(db, store)
+	commentHandler := comment.NewHandler(db)
 
 	// Router
 	router := gin.Default()
@@ -109,18 +133,40 @@ func main() {
 		AllowHeaders: []string{"Origin", "Content-Type", "Authorization"},
 	}))
 
+	// Images kept on this server are served from it
+	if conf.Storage.Driver == "local" {
+		router.Static("/uploads", conf.Storage.Dir)
+	}
+
 	// Register route
 	group := router.Group("/api/v1")
 	group.GET("/food-recipes", foodRecipeHandler.Get)
 	group.GET("/food-recipes/favorites", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.GetFavorites)
-	group.GET("/food-recipes/:id", foodRecipeHandler.GetByID)
+	group.GET("/food-recipes/cookable", middleware.Authorize(verifierSkipClientCheck), pantryHandler.GetCookable)
+	group.GET("/food-recipes/:id", middleware.OptionalAuthorize(verifierSkipClientCheck), foodRecipeHandler.GetByID)
+	group.GET("/food-recipes/:id/scaled", middleware.OptionalAuthorize(verifierSkipClientCheck), foodRecipeHandler.GetScaled)
 	group.POST("/food-recipes", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.Create)
+	group.POST("/food-recipes/import", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.Import)
 	group.PUT("/food-recipes/:id", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.Update)
 	group.DELETE("/food-recipes/:id", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.Delete)
+	group.POST("/food-recipes/:id/image", middleware.Authorize(verifierSkipClientCheck), uploadHandler.RecipeImage)
+	group.POST("/food-recipes/:id/publish", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.Publish)
+	group.POST("/food-recipes/:id/fork", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.Fork)
+	group.GET("/food-recipes/:id/forks", middleware.OptionalAuthorize(verifierSkipClientCheck), foodRecipeHandler.GetForks)
+	group.GET("/food-recipes/:id/revisions", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.GetRevisions)
+	group.GET("/food-recipes/:id/revisions/diff", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.DiffRevisions)
+	group.GET("/food-recipes/:id/revisions/:rev", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.GetRevision)
+	group.POST("/food-recipes/:id/revisions/:rev/revert", middleware.Authorize(verifierSkipClientCheck), foodRecipeHandler.Revert)
 	group.POST("/food-recipes/:id/ratings", middleware.Authorize(verifierSkipClientCheck), ratingHandler.Create)
-	group.GET("/food-recipes/:id/ratings", ratingHandler.GetByID)
+	group.GET("/food-recipes/:id/ratings", middleware.OptionalAuthorize(verifierSkipClientCheck), ratingHandler.GetByID)
+	group.GET("/food-recipes/:id/ratings/me", middleware.Authorize(verifierSkipClientCheck), ratingHandler.GetMine)
 	group.POST("/food-recipes/:id/favorite", middleware.Authorize(verifierSkipClientCheck), ratingHandler.Favorite)
 	group.GET("/food-recipes/:id/favorite", middleware.Authorize(verifierSkipClientCheck), ratingHandler.IsFavorite)
+	group.GET("/food-recipes/:id/comments", middleware.OptionalAuthorize(verifierSkipClientCheck), commentHandler.Get)
+	group.POST("/food-recipes/:id/comments", middleware.Authorize(verifierSkipClientCheck), commentHandler.Create)
+	group.PUT("/food-recipes/:id/comments/:commentId", middleware.Authorize(verifierSkipClientCheck), commentHandler.Update)
+	group.DELETE("/food-recipes/:id/comments/:commentId", middleware.Authorize(verifierSkipClientCheck), commentHandler.Delete)
+	group.GET("/food-recipes/:id/comments/:commentId/replies", middleware.OptionalAuthorize(verifierSkipClientCheck), commentHandler.GetReplies)
 
 	// Auth
 	group.GET("/login", authHandler.Login)
@@ -130,8 +176,55 @@ func main() {
 	// User
 	group.GET("/users/:id", userHandler.GetByID)
 	group.PUT("/users/:id", middleware.Authorize(verifierSkipClientCheck), userHandler.Update)
+	group.POST("/users/:id/image", middleware.Authorize(verifierSkipClientCheck), uploadHandler.Avatar)
 	group.GET("/users/:id/food-recipes", middleware.Authorize(verifierSkipClientCheck), userHandler.GetRecipes)
 
+	// Tag
+	group.GET("/tags", tagHandler.Get)
+	group.POST("/tags", middleware.Authorize(verifierSkipClientCheck), middleware.RequireRole(model.RoleAdmin), tagHandler.Create)
+	group.PUT("/tags/:id", middleware.Authorize(verifierSkipClientCheck), middleware.RequireRole(model.RoleAdmin), tagHandler.Update)
+	group.DELETE("/tags/:id", middleware.Authorize(verifierSkipClientCheck), middleware.RequireRole(model.RoleAdmin), tagHandler.Delete)
+	group.GET("/tag-groups", tagHandler.GetGroups)
+	group.POST("/tag-groups", middleware.Authorize(verifierSkipClientCheck), middleware.RequireRole(model.RoleAdmin), tagHandler.CreateGroup)
+	group.PUT("/tag-groups/:id", middleware.Authorize(verifierSkipClientCheck), middleware.RequireRole(model.RoleAdmin), tagHandler.UpdateGroup)
+	group.DELETE("/tag-groups/:id", middleware.Authorize(verifierSkipClientCheck), middleware.RequireRole(model.RoleAdmin), tagHandler.DeleteGroup)
+
+	// Collection
+	group.GET("/collections", middleware.OptionalAuthorize(verifierSkipClientCheck), collectionHandler.Get)
+	group.POST("/collections", middleware.Authorize(verifierSkipClientCheck), collectionHandler.Create)
+	group.GET("/collections/:id", middleware.OptionalAuthorize(verifierSkipClientCheck), collectionHandler.GetByID)
+	group.PUT("/collections/:id", middleware.Authorize(verifierSkipClientCheck), collectionHandler.Update)
+	group.DELETE("/collections/:id", middleware.Authorize(verifierSkipClientCheck), collectionHandler.Delete)
+	group.GET("/collections/:id/recipes", middleware.OptionalAuthorize(verifierSkipClientCheck), collectionHandler.GetRecipes)
+	group.POST("/collections/:id/recipes", middleware.Authorize(verifierSkipClientCheck), collectionHandler.AddRecipe)
+	group.PUT("/collections/:id/recipes/order", middleware.Authorize(verifierSkipClientCheck), collectionHandler.Reorder)
+	group.DELETE("/collections/:id/recipes/:recipeId", middleware.Authorize(verifierSkipClientCheck), collectionHandler.RemoveRecipe)
+
+	// Meal plan
+	group.GET("/meal-plan", middleware.Authorize(verifierSkipClientCheck), mealPlanHandler.Get)
+	group.POST("/meal-plan/entries", middleware.Authorize(verifierSkipClientCheck), mealPlanHandler.AddEntry)
+	group.DELETE("/meal-plan/entries/:id", middleware.Authorize(verifierSkipClientCheck), mealPlanHandler.DeleteEntry)
+	group.POST("/meal-plan/copy", middleware.Authorize(verifierSkipClientCheck), mealPlanHandler.CopyWeek)
+	group.DELETE("/meal-plan/days/:date", middleware.Authorize(verifierSkipClientCheck), mealPlanHandler.ClearDay)
+
+	// Shopping list
+	group.GET("/shopping-lists", middleware.Authorize(verifierSkipClientCheck), shoppingListHandler.Get)
+	group.POST("/shopping-lists", middleware.Authorize(verifierSkipClientCheck), shoppingListHandler.Create)
+	group.GET("/shopping-lists/:id", middleware.Authorize(verifierSkipClientCheck), shoppingListHandler.GetByID)
+	group.DELETE("/shopping-lists/:id", middleware.Authorize(verifierSkipClientCheck), shoppingListHandler.Delete)
+	group.GET("/shopping-lists/:id/export", middleware.Authorize(verifierSkipClientCheck), shoppingListHandler.Export)
+	group.PUT("/shopping-lists/:id/items/:itemId", middleware.Authorize(verifierSkipClientCheck), shoppingListHandler.UpdateItem)
+
+	// Pantry
+	group.GET("/pantry", middleware.Authorize(verifierSkipClientCheck), pantryHandler.Get)
+	group.POST("/pantry", middleware.Authorize(verifierSkipClientCheck), pantryHandler.Add)
+	group.PUT("/pantry/:id", middleware.Authorize(verifierSkipClientCheck), pantryHandler.Update)
+	group.DELETE("/pantry/:id", middleware.Authorize(verifierSkipClientCheck), pantryHandler.Delete)
+
+	// Admin
+	group.GET("/admin/food-recipes/export", middleware.Authorize(verifierSkipClientCheck), middleware.RequireRole(model.RoleAdmin), bulkHandler.Export)
+	group.POST("/admin/food-recipes/import", middleware.Authorize(verifierSkipClientCheck), middleware.RequireRole(model.RoleAdmin), bulkHandler.Import)
+
 	if err := router.Run(); err != nil {
 		log.Fatal("Server error:", err)
 	}
@@ -4,4 +4,5 @@ package config
 type Config struct {
 	Database Database
 	Keycloak Keycloak
+	Storage  Storage
 }
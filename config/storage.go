@@ -0,0 +1,15 @@
+package config
+
+// Storage picks where uploaded images are kept: a local directory, served
+// by the API under /uploads, or an S3-compatible bucket.
+type Storage struct {
+	Driver    string `env:"STORAGE_DRIVER" envDefault:"local"`
+	Dir       string `env:"STORAGE_DIR" envDefault:"uploads"`
+	PublicURL string `env:"STORAGE_PUBLIC_URL"` // defaults to the API for local, the bucket for S3
+
+	S3Endpoint        string `env:"S3_ENDPOINT"`
+	S3Region          string `env:"S3_REGION" envDefault:"us-east-1"`
+	S3Bucket          string `env:"S3_BUCKET"`
+	S3AccessKeyID     string `env:"S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `env:"S3_SECRET_ACCESS_KEY"`
+}
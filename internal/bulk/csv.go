@@ -0,0 +1,194 @@
+package bulk
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// columns are the CSV columns, named like the JSON properties of a Record.
+// The ingredients and steps columns are text to read; the data columns at
+// the end hold them as JSON.
+var columns = []string{
+	"id", "name", "description", "ingredients", "steps", "imageUrl", "servings", "status",
+	"difficulty", "cookingDuration", "tags", "authorId", "authorName", "ratingCount", "averageRating", "createdAt",
+	"ingredientsData", "stepsData",
+}
+
+// requiredColumns are the columns an import cannot do without.
+var requiredColumns = []string{"name", "difficulty", "cookingDuration"}
+
+type csvWriter struct {
+	writer *csv.Writer
+	header bool
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{writer: csv.NewWriter(w)}
+}
+
+func (w *csvWriter) Write(record Record) error {
+	if !w.header {
+		if err := w.writer.Write(columns); err != nil {
+			return err
+		}
+		w.header = true
+	}
+
+	createdAt := ""
+	if !record.CreatedAt.IsZero() {
+		createdAt = record.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	ingredients := make([]string, 0, len(record.Ingredients))
+	for _, ingredient := range record.Ingredients {
+		ingredients = append(ingredients, ingredient.String())
+	}
+
+	steps := make([]string, 0, len(record.Steps))
+	for _, step := range record.Steps {
+		steps = append(steps, step.Text)
+	}
+
+	ingredientsData, err := json.Marshal(record.Ingredients)
+	if err != nil {
+		return err
+	}
+
+	stepsData, err := json.Marshal(record.Steps)
+	if err != nil {
+		return err
+	}
+
+	return w.writer.Write([]string{
+		strconv.FormatUint(uint64(record.ID), 10),
+		record.Name,
+		record.Description,
+		strings.Join(ingredients, "\n"),
+		strings.Join(steps, "\n"),
+		record.ImageURL,
+		strconv.Itoa(record.Servings),
+		record.Status,
+		record.Difficulty,
+		record.CookingDuration,
+		strings.Join(record.Tags, "\n"),
+		record.AuthorID,
+		record.AuthorName,
+		strconv.Itoa(record.RatingCount),
+		strconv.FormatFloat(record.AverageRating, 'f', -1, 64),
+		createdAt,
+		string(ingredientsData),
+		string(stepsData),
+	})
+}
+
+// Flush writes out the buffered rows. An export without recipes still gets
+// its header.
+func (w *csvWriter) Flush() error {
+	if !w.header {
+		if err := w.writer.Write(columns); err != nil {
+			return err
+		}
+		w.header = true
+	}
+
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type csvReader struct {
+	reader *csv.Reader
+	// index maps the lowercased column names to their position in a row
+	index map[string]int
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(ErrInvalidHeader, "empty file")
+	}
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidHeader, err.Error())
+	}
+
+	index := make(map[string]int, len(header))
+	for position, name := range header {
+		// Spreadsheets saving as UTF-8 start the file with a byte order mark
+		name = strings.TrimPrefix(name, "\ufeff")
+		index[strings.ToLower(strings.TrimSpace(name))] = position
+	}
+
+	for _, column := range requiredColumns {
+		if _, ok := index[strings.ToLower(column)]; !ok {
+			return nil, errors.Wrapf(ErrInvalidHeader, "missing column %q", column)
+		}
+	}
+
+	return &csvReader{reader: reader, index: index}, nil
+}
+
+func (r *csvReader) Read() (Record, error) {
+	row, err := r.reader.Read()
+	if err != nil {
+		var parseError *csv.ParseError
+		if errors.As(err, &parseError) {
+			return Record{}, errors.Wrap(ErrInvalidRow, parseError.Error())
+		}
+		return Record{}, err
+	}
+
+	value := func(column string) string {
+		position, ok := r.index[strings.ToLower(column)]
+		if !ok || position >= len(row) {
+			return ""
+		}
+		return row[position]
+	}
+
+	record := Record{
+		Name:            strings.TrimSpace(value("name")),
+		Description:     strings.TrimSpace(value("description")),
+		ImageURL:        strings.TrimSpace(value("imageUrl")),
+		Status:          strings.TrimSpace(value("status")),
+		Difficulty:      strings.TrimSpace(value("difficulty")),
+		CookingDuration: strings.TrimSpace(value("cookingDuration")),
+		Tags:            lines(value("tags")),
+		AuthorID:        strings.TrimSpace(value("authorId")),
+	}
+
+	if data := strings.TrimSpace(value("ingredientsData")); data != "" {
+		if err := json.Unmarshal([]byte(data), &record.Ingredients); err != nil {
+			return Record{}, errors.Wrapf(ErrInvalidRow, "ingredientsData: %s", err)
+		}
+	} else {
+		for _, line := range lines(value("ingredients")) {
+			record.Ingredients = append(record.Ingredients, parseIngredient(line))
+		}
+	}
+
+	if data := strings.TrimSpace(value("stepsData")); data != "" {
+		if err := json.Unmarshal([]byte(data), &record.Steps); err != nil {
+			return Record{}, errors.Wrapf(ErrInvalidRow, "stepsData: %s", err)
+		}
+	} else {
+		for _, line := range lines(value("steps")) {
+			record.Steps = append(record.Steps, Step{Text: line})
+		}
+	}
+
+	if servings := strings.TrimSpace(value("servings")); servings != "" {
+		if record.Servings, err = strconv.Atoi(servings); err != nil {
+			return Record{}, errors.Wrapf(ErrInvalidRow, "servings %q is not a number", servings)
+		}
+	}
+
+	return record, nil
+}
@@ -0,0 +1,43 @@
+package bulk
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Writer writes records to a bulk file. Writes may be buffered until Flush.
+type Writer interface {
+	Write(record Record) error
+	Flush() error
+}
+
+// Reader reads the records of a bulk file one by one. Read returns io.EOF
+// after the last one, and an error wrapping ErrInvalidRow for a row it cannot
+// read, after which it goes on with the next row.
+type Reader interface {
+	Read() (Record, error)
+}
+
+// NewWriter starts a bulk file in the format. CSV files start with a header.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case CSV:
+		return newCSVWriter(w), nil
+	case JSONL:
+		return newJSONLWriter(w), nil
+	}
+	return nil, errors.Wrap(ErrUnknownFormat, string(format))
+}
+
+// NewReader opens a bulk file in the format. CSV files must start with a
+// header naming at least the name, difficulty and cookingDuration columns.
+func NewReader(format Format, r io.Reader) (Reader, error) {
+	switch format {
+	case CSV:
+		return newCSVReader(r)
+	case JSONL:
+		return newJSONLReader(r), nil
+	}
+	return nil, errors.Wrap(ErrUnknownFormat, string(format))
+}
@@ -0,0 +1,218 @@
+package bulk_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/bulk"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func quantity(value float64) *float64 {
+	return &value
+}
+
+func seconds(value int) *int {
+	return &value
+}
+
+func text(value string) *string {
+	return &value
+}
+
+func padKraPao() model.FoodRecipe {
+	return model.FoodRecipe{
+		Model:       gorm.Model{ID: 7, CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)},
+		Name:        "Pad Kra Pao",
+		Description: "Stir-fried pork, \"the\" Thai lunch.",
+		Ingredients: model.RecipeIngredients{
+			{Name: "minced pork", Quantity: quantity(300), Unit: "g", Position: 1},
+			{Name: "holy basil", Quantity: quantity(1), Unit: "cup", Note: "leaves only", Position: 2},
+			{Name: "clove garlic", Quantity: quantity(2), Position: 3},
+			{Name: "ไข่ไก่", Quantity: quantity(2), Unit: "ฟอง", Position: 4},
+		},
+		Steps: model.RecipeSteps{
+			{Position: 1, Text: "Fry the garlic, then the pork.", DurationSeconds: seconds(180), ImageURL: text("https://example.com/fry.jpg")},
+			{Position: 2, Text: "Fold in the basil."},
+		},
+		Servings:        2,
+		Status:          model.RecipeStatusPublished,
+		CookingDuration: model.CookingDuration{Name: "11 - 30"},
+		Difficulty:      model.Difficulty{Name: "Easy"},
+		Tags:            model.Tags{{Name: "Thai"}, {Name: "Stir-fry"}},
+		UserID:          "somchai",
+		User:            model.User{FirstName: "Somchai", LastName: "Jaidee"},
+		RatingCount:     3,
+		AverageRating:   14.0 / 3,
+	}
+}
+
+func TestFromRecipe(t *testing.T) {
+	t.Run("ShouldCarryNamesAndRatingsSummary", func(t *testing.T) {
+		record := bulk.FromRecipe(padKraPao())
+
+		assert.Equal(t, bulk.Ingredient{Quantity: quantity(1), Unit: "cup", Name: "holy basil", Note: "leaves only"}, record.Ingredients[1])
+		assert.Equal(t, bulk.Step{Text: "Fry the garlic, then the pork.", DurationSeconds: seconds(180), ImageURL: "https://example.com/fry.jpg"}, record.Steps[0])
+		assert.Equal(t, []string{"Thai", "Stir-fry"}, record.Tags)
+		assert.Equal(t, "Easy", record.Difficulty)
+		assert.Equal(t, "11 - 30", record.CookingDuration)
+		assert.Equal(t, "Somchai Jaidee", record.AuthorName)
+		assert.Equal(t, 3, record.RatingCount)
+		assert.Equal(t, 4.67, record.AverageRating)
+	})
+
+	t.Run("ShouldFallBackToLegacyText", func(t *testing.T) {
+		record := bulk.FromRecipe(model.FoodRecipe{Ingredient: "rice\n\n2 eggs", Instruction: "Cook.\nEat."})
+
+		assert.Equal(t, []bulk.Ingredient{{Name: "rice"}, {Name: "eggs", Quantity: quantity(2)}}, record.Ingredients)
+		assert.Equal(t, []bulk.Step{{Text: "Cook."}, {Text: "Eat."}}, record.Steps)
+	})
+}
+
+func roundTrip(t *testing.T, format bulk.Format) {
+	var buffer bytes.Buffer
+	writer, err := bulk.NewWriter(format, &buffer)
+	require.NoError(t, err)
+
+	exported := bulk.FromRecipe(padKraPao())
+	require.NoError(t, writer.Write(exported))
+	require.NoError(t, writer.Flush())
+
+	reader, err := bulk.NewReader(format, &buffer)
+	require.NoError(t, err)
+
+	record, err := reader.Read()
+	require.NoError(t, err)
+
+	assert.Equal(t, exported.Name, record.Name)
+	assert.Equal(t, exported.Description, record.Description)
+	assert.Equal(t, exported.Ingredients, record.Ingredients)
+	assert.Equal(t, exported.Steps, record.Steps)
+	assert.Equal(t, exported.Tags, record.Tags)
+	assert.Equal(t, 2, record.Servings)
+	assert.Equal(t, "published", record.Status)
+	assert.Equal(t, "Easy", record.Difficulty)
+	assert.Equal(t, "11 - 30", record.CookingDuration)
+	assert.Equal(t, "somchai", record.AuthorID)
+
+	_, err = reader.Read()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestCSV(t *testing.T) {
+	t.Run("ShouldReadBackWhatItWrites", func(t *testing.T) {
+		roundTrip(t, bulk.CSV)
+	})
+
+	t.Run("ShouldWriteHeaderForEmptyExport", func(t *testing.T) {
+		var buffer bytes.Buffer
+		writer, err := bulk.NewWriter(bulk.CSV, &buffer)
+		require.NoError(t, err)
+		require.NoError(t, writer.Flush())
+
+		assert.True(t, strings.HasPrefix(buffer.String(), "id,name,description,ingredients,steps,"))
+	})
+
+	t.Run("ShouldShowIngredientsAndStepsAsText", func(t *testing.T) {
+		var buffer bytes.Buffer
+		writer, err := bulk.NewWriter(bulk.CSV, &buffer)
+		require.NoError(t, err)
+		require.NoError(t, writer.Write(bulk.FromRecipe(padKraPao())))
+		require.NoError(t, writer.Flush())
+
+		assert.Contains(t, buffer.String(), "\"300 g minced pork\n1 cup holy basil (leaves only)\n2 clove garlic\n2 ฟอง ไข่ไก่\"")
+		assert.Contains(t, buffer.String(), "\"Fry the garlic, then the pork.\nFold in the basil.\"")
+	})
+
+	t.Run("ShouldReadTextWithoutDataColumns", func(t *testing.T) {
+		file := "name,difficulty,cookingDuration,ingredients,steps\nRice,Easy,5 - 10,\"2 cups rice\n\nน้ำ 3 ถ้วย\",\"Rinse.\nCook.\"\n"
+
+		reader, err := bulk.NewReader(bulk.CSV, strings.NewReader(file))
+		require.NoError(t, err)
+
+		record, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, []bulk.Ingredient{{Quantity: quantity(2), Unit: "cups", Name: "rice"}, {Quantity: quantity(3), Unit: "ถ้วย", Name: "น้ำ"}}, record.Ingredients)
+		assert.Equal(t, []bulk.Step{{Text: "Rinse."}, {Text: "Cook."}}, record.Steps)
+	})
+
+	t.Run("ShouldReadColumnsInAnyOrder", func(t *testing.T) {
+		file := "\ufeffCookingDuration,Name,Difficulty,Servings\n5 - 10,Rice,Easy,2\n"
+
+		reader, err := bulk.NewReader(bulk.CSV, strings.NewReader(file))
+		require.NoError(t, err)
+
+		record, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, bulk.Record{Name: "Rice", Difficulty: "Easy", CookingDuration: "5 - 10", Servings: 2}, record)
+	})
+
+	t.Run("ShouldRejectHeaderWithoutRequiredColumn", func(t *testing.T) {
+		_, err := bulk.NewReader(bulk.CSV, strings.NewReader("name,difficulty\nRice,Easy\n"))
+		assert.ErrorIs(t, err, bulk.ErrInvalidHeader)
+	})
+
+	t.Run("ShouldGoOnAfterInvalidRow", func(t *testing.T) {
+		file := "name,difficulty,cookingDuration,servings\nRice,Easy,5 - 10,two\nEggs,Easy,5 - 10,1\n"
+
+		reader, err := bulk.NewReader(bulk.CSV, strings.NewReader(file))
+		require.NoError(t, err)
+
+		_, err = reader.Read()
+		assert.ErrorIs(t, err, bulk.ErrInvalidRow)
+
+		record, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, "Eggs", record.Name)
+	})
+}
+
+func TestJSONL(t *testing.T) {
+	t.Run("ShouldReadBackWhatItWrites", func(t *testing.T) {
+		roundTrip(t, bulk.JSONL)
+	})
+
+	t.Run("ShouldSkipBlankLinesAndGoOnAfterInvalidLine", func(t *testing.T) {
+		file := "{\"name\":\"Rice\"}\n\n{not json}\n{\"name\":\"Eggs\"}"
+
+		reader, err := bulk.NewReader(bulk.JSONL, strings.NewReader(file))
+		require.NoError(t, err)
+
+		record, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, "Rice", record.Name)
+
+		_, err = reader.Read()
+		assert.ErrorIs(t, err, bulk.ErrInvalidRow)
+
+		record, err = reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, "Eggs", record.Name)
+
+		_, err = reader.Read()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("ShouldReadIngredientsAndStepsWrittenAsText", func(t *testing.T) {
+		file := `{"name":"Rice","ingredients":["2 cups rice",{"name":"salt","note":"a pinch"}],"steps":["Cook.",{"text":"Rest.","durationSeconds":300}]}`
+
+		reader, err := bulk.NewReader(bulk.JSONL, strings.NewReader(file))
+		require.NoError(t, err)
+
+		record, err := reader.Read()
+		require.NoError(t, err)
+		assert.Equal(t, []bulk.Ingredient{{Quantity: quantity(2), Unit: "cups", Name: "rice"}, {Name: "salt", Note: "a pinch"}}, record.Ingredients)
+		assert.Equal(t, []bulk.Step{{Text: "Cook."}, {Text: "Rest.", DurationSeconds: seconds(300)}}, record.Steps)
+	})
+
+	t.Run("ShouldRejectUnknownFormat", func(t *testing.T) {
+		_, err := bulk.NewReader("xlsx", strings.NewReader(""))
+		assert.ErrorIs(t, err, bulk.ErrUnknownFormat)
+	})
+}
@@ -0,0 +1,111 @@
+package bulk
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MaxImportSize is the largest file POST /admin/food-recipes/import reads.
+// Larger migrations go through cmd/recipes.
+const MaxImportSize = 32 << 20
+
+type IHandler interface {
+	Export(ctx *gin.Context)
+	Import(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) IHandler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+func (handler Handler) Export(ctx *gin.Context) {
+	var query model.BulkQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	format := Format(query.Format)
+	ctx.Header("Content-Type", format.ContentType())
+	ctx.Header("Content-Disposition", `attachment; filename="food-recipes`+format.Extension()+`"`)
+	ctx.Status(http.StatusOK)
+
+	count, err := handler.Service.Export(format, ctx.Writer)
+	if err != nil {
+		// Once rows are sent the status cannot change, the file is cut short
+		if !ctx.Writer.Written() {
+			ctx.Writer.Header().Del("Content-Type")
+			ctx.Writer.Header().Del("Content-Disposition")
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+		log.Printf("Export failed after %d recipes: %v", count, err)
+		ctx.Abort()
+	}
+}
+
+func (handler Handler) Import(ctx *gin.Context) {
+	var query model.BulkQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	header, err := helper.FormFile(ctx, "file", MaxImportSize)
+	if errors.Is(err, global.ErrorTooLarge) {
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"message": "File is too large"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	report, err := handler.Service.Import(Format(query.Format), file, query.DryRun, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	// A report with row errors committed nothing
+	if len(report.Errors) > 0 {
+		ctx.JSON(http.StatusBadRequest, report.ToResponse())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report.ToResponse())
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, ErrInvalidHeader), errors.Is(err, ErrUnknownFormat):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,62 @@
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+type jsonlWriter struct {
+	buffer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	buffer := bufio.NewWriter(w)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+
+	return &jsonlWriter{buffer: buffer, encoder: encoder}
+}
+
+// Write writes the record on a line of its own.
+func (w *jsonlWriter) Write(record Record) error {
+	return w.encoder.Encode(record)
+}
+
+func (w *jsonlWriter) Flush() error {
+	return w.buffer.Flush()
+}
+
+type jsonlReader struct {
+	reader *bufio.Reader
+}
+
+func newJSONLReader(r io.Reader) *jsonlReader {
+	return &jsonlReader{reader: bufio.NewReader(r)}
+}
+
+// Read reads the record on the next line that is not blank.
+func (r *jsonlReader) Read() (Record, error) {
+	for {
+		line, err := r.reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) == 0 {
+			if err != nil {
+				return Record{}, err
+			}
+			continue
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return Record{}, err
+		}
+
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			return Record{}, errors.Wrap(ErrInvalidRow, err.Error())
+		}
+		return record, nil
+	}
+}
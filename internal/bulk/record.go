@@ -0,0 +1,205 @@
+// Package bulk moves recipes in and out in batches, as CSV or JSON Lines.
+//
+// Both formats hold the same Record. Lists, such as the ingredients or the
+// tags, are a JSON array in JSONL and one item per line of the cell in CSV.
+// Ingredients and steps are objects in JSONL. CSV shows them as text and
+// keeps the objects in the ingredientsData and stepsData columns, so that
+// nothing is lost when an export is imported again; a CSV written by hand
+// may leave those columns out, and its text is then read like a recipe's.
+// An export carries what a migration needs to check the data. An import
+// ignores what is only exported (the ID, the author name, the ratings and
+// the creation time), and every recipe it creates gets a new ID.
+package bulk
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown format")
+	ErrInvalidHeader = errors.New("invalid header")
+	ErrInvalidRow    = errors.New("invalid row")
+)
+
+type Format string
+
+const (
+	CSV   Format = "csv"
+	JSONL Format = "jsonl"
+)
+
+// ContentType is what an export in the format is sent as.
+func (format Format) ContentType() string {
+	switch format {
+	case CSV:
+		return "text/csv; charset=utf-8"
+	case JSONL:
+		return "application/x-ndjson; charset=utf-8"
+	}
+	return ""
+}
+
+// Extension names files of the format.
+func (format Format) Extension() string {
+	switch format {
+	case CSV:
+		return ".csv"
+	case JSONL:
+		return ".jsonl"
+	}
+	return ""
+}
+
+// Record is one recipe in a bulk file. Difficulty, cooking duration and tags
+// go by name, so a file moves between databases whose IDs differ.
+type Record struct {
+	ID              uint         `json:"id,omitempty"`
+	Name            string       `json:"name"`
+	Description     string       `json:"description"`
+	Ingredients     []Ingredient `json:"ingredients"`
+	Steps           []Step       `json:"steps"`
+	ImageURL        string       `json:"imageUrl,omitempty"`
+	Servings        int          `json:"servings,omitempty"`
+	Status          string       `json:"status,omitempty"`
+	Difficulty      string       `json:"difficulty"`
+	CookingDuration string       `json:"cookingDuration"`
+	Tags            []string     `json:"tags"`
+	AuthorID        string       `json:"authorId,omitempty"`
+	AuthorName      string       `json:"authorName,omitempty"`
+	RatingCount     int          `json:"ratingCount"`
+	AverageRating   float64      `json:"averageRating"`
+	CreatedAt       time.Time    `json:"createdAt"`
+}
+
+// Ingredient is an ingredient of a record. In JSONL it may also be written
+// as a line of text, such as "2 tbsp fish sauce".
+type Ingredient struct {
+	Quantity *float64 `json:"quantity,omitempty"`
+	Unit     string   `json:"unit,omitempty"`
+	Name     string   `json:"name"`
+	Note     string   `json:"note,omitempty"`
+}
+
+func parseIngredient(line string) Ingredient {
+	request := foodrecipe.ParseIngredient(line)
+	return Ingredient{Quantity: request.Quantity, Unit: request.Unit, Name: request.Name, Note: request.Note}
+}
+
+func (ingredient *Ingredient) UnmarshalJSON(data []byte) error {
+	var line string
+	if err := json.Unmarshal(data, &line); err == nil {
+		*ingredient = parseIngredient(line)
+		return nil
+	}
+
+	type object Ingredient
+	return json.Unmarshal(data, (*object)(ingredient))
+}
+
+// String is the ingredient as the CSV shows it.
+func (ingredient Ingredient) String() string {
+	return model.RecipeIngredient{
+		Quantity: ingredient.Quantity,
+		Unit:     ingredient.Unit,
+		Name:     ingredient.Name,
+		Note:     ingredient.Note,
+	}.String()
+}
+
+// Step is a step of a record. In JSONL it may also be written as its text.
+type Step struct {
+	Text            string `json:"text"`
+	DurationSeconds *int   `json:"durationSeconds,omitempty"`
+	ImageURL        string `json:"imageUrl,omitempty"`
+}
+
+func (step *Step) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*step = Step{Text: text}
+		return nil
+	}
+
+	type object Step
+	return json.Unmarshal(data, (*object)(step))
+}
+
+// FromRecipe writes a recipe, with its author, difficulty, cooking duration
+// and tags preloaded and its average rating calculated, as a record.
+// Without structured ingredients and steps, the lines of the legacy text
+// are read as them.
+func FromRecipe(recipe model.FoodRecipe) Record {
+	record := Record{
+		ID:              recipe.ID,
+		Name:            recipe.Name,
+		Description:     recipe.Description,
+		Ingredients:     make([]Ingredient, 0, len(recipe.Ingredients)),
+		Steps:           make([]Step, 0, len(recipe.Steps)),
+		Servings:        recipe.Servings,
+		Status:          recipe.Status,
+		Difficulty:      recipe.Difficulty.Name,
+		CookingDuration: recipe.CookingDuration.Name,
+		Tags:            make([]string, 0, len(recipe.Tags)),
+		AuthorID:        recipe.UserID,
+		AuthorName:      strings.TrimSpace(recipe.User.FirstName + " " + recipe.User.LastName),
+		RatingCount:     int(recipe.RatingCount),
+		AverageRating:   math.Round(recipe.AverageRating*100) / 100,
+		CreatedAt:       recipe.CreatedAt,
+	}
+
+	if recipe.ImageURL != nil {
+		record.ImageURL = *recipe.ImageURL
+	}
+
+	if len(recipe.Ingredients) > 0 {
+		for _, ingredient := range recipe.Ingredients {
+			record.Ingredients = append(record.Ingredients, Ingredient{
+				Quantity: ingredient.Quantity,
+				Unit:     ingredient.Unit,
+				Name:     ingredient.Name,
+				Note:     ingredient.Note,
+			})
+		}
+	} else {
+		for _, line := range lines(recipe.Ingredient) {
+			record.Ingredients = append(record.Ingredients, parseIngredient(line))
+		}
+	}
+
+	if len(recipe.Steps) > 0 {
+		for _, step := range recipe.Steps {
+			item := Step{Text: step.Text, DurationSeconds: step.DurationSeconds}
+			if step.ImageURL != nil {
+				item.ImageURL = *step.ImageURL
+			}
+			record.Steps = append(record.Steps, item)
+		}
+	} else {
+		for _, line := range lines(recipe.Instruction) {
+			record.Steps = append(record.Steps, Step{Text: line})
+		}
+	}
+
+	for _, tag := range recipe.Tags {
+		record.Tags = append(record.Tags, tag.Name)
+	}
+
+	return record
+}
+
+func lines(text string) []string {
+	var results []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			results = append(results, line)
+		}
+	}
+	return results
+}
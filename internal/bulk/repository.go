@@ -0,0 +1,117 @@
+package bulk
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Row imports one row through the recipe service it is given.
+type Row func(recipes foodrecipe.IService) error
+
+type IRepository interface {
+	Each(size int, fn func(recipes model.FoodRecipes) error) error
+	GetDifficulties() ([]model.Difficulty, error)
+	GetCookingDurations() ([]model.CookingDuration, error)
+	GetTags() (model.Tags, error)
+	GetUserIDs(ids []string) ([]string, error)
+	Import(rows []Row, commit bool) ([]error, error)
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// errRollback ends the import transaction without committing it.
+var errRollback = errors.New("roll back import")
+
+// exportAssociations loads what a record is written from, keeping structured
+// ingredients and steps in the order the author wrote them.
+func exportAssociations(db *gorm.DB) *gorm.DB {
+	byPosition := func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	}
+
+	return db.Preload("User").
+		Preload("Difficulty").
+		Preload("CookingDuration").
+		Preload("Tags").
+		Preload("Ingredients", byPosition).
+		Preload("Steps", byPosition)
+}
+
+// Each goes through every recipe, oldest first, a batch of size recipes at a
+// time, so an export never holds them all.
+func (repo Repository) Each(size int, fn func(recipes model.FoodRecipes) error) error {
+	var recipes model.FoodRecipes
+	return repo.DB.Scopes(exportAssociations).
+		FindInBatches(&recipes, size, func(tx *gorm.DB, batch int) error {
+			return fn(recipes)
+		}).Error
+}
+
+func (repo Repository) GetDifficulties() ([]model.Difficulty, error) {
+	var difficulties = make([]model.Difficulty, 0)
+	err := repo.DB.Order("id asc").Find(&difficulties).Error
+	return difficulties, err
+}
+
+func (repo Repository) GetCookingDurations() ([]model.CookingDuration, error) {
+	var durations = make([]model.CookingDuration, 0)
+	err := repo.DB.Order("id asc").Find(&durations).Error
+	return durations, err
+}
+
+func (repo Repository) GetTags() (model.Tags, error) {
+	var tags = make(model.Tags, 0)
+	err := repo.DB.Order("id asc").Find(&tags).Error
+	return tags, err
+}
+
+// GetUserIDs returns which of the user IDs exist.
+func (repo Repository) GetUserIDs(ids []string) ([]string, error) {
+	var found = make([]string, 0)
+	if len(ids) == 0 {
+		return found, nil
+	}
+
+	err := repo.DB.Model(&model.User{}).Where("id IN ?", ids).Pluck("id", &found).Error
+	return found, err
+}
+
+// Import runs the rows in one transaction, each in a savepoint of its own so
+// a failing row neither aborts the transaction nor leaves half a recipe
+// behind. It returns the error of every row, nil for the ones that went in,
+// and commits only when asked to and no row failed.
+func (repo Repository) Import(rows []Row, commit bool) ([]error, error) {
+	rowErrors := make([]error, len(rows))
+
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		failed := false
+		for index, row := range rows {
+			rowErrors[index] = tx.Transaction(func(tx *gorm.DB) error {
+				return row(foodrecipe.NewService(tx))
+			})
+			if rowErrors[index] != nil {
+				failed = true
+			}
+		}
+
+		if !commit || failed {
+			return errRollback
+		}
+		return nil
+	})
+	if errors.Is(err, errRollback) {
+		err = nil
+	}
+
+	return rowErrors, err
+}
@@ -0,0 +1,298 @@
+package bulk
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// BatchSize is how many recipes an export loads at a time.
+const BatchSize = 100
+
+type IService interface {
+	Export(format Format, w io.Writer) (int, error)
+	Import(format Format, r io.Reader, dryRun bool, claims model.Claims) (model.BulkReport, error)
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+// Export writes every recipe, whatever its status, and returns how many it
+// wrote. Each batch is flushed once written, so the file streams out.
+func (service Service) Export(format Format, w io.Writer) (int, error) {
+	writer, err := NewWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = service.Repository.Each(BatchSize, func(recipes model.FoodRecipes) error {
+		for _, recipe := range recipes {
+			if err := writer.Write(FromRecipe(recipe)); err != nil {
+				return err
+			}
+			count++
+		}
+		return writer.Flush()
+	})
+	if err != nil {
+		return count, errors.Wrap(err, "export recipes")
+	}
+
+	if err := writer.Flush(); err != nil {
+		return count, errors.Wrap(err, "export recipes")
+	}
+
+	return count, nil
+}
+
+// Import creates a recipe for every row, validated like one written here.
+// Rows without an author ID are credited to the caller. Published rows are
+// created as drafts then published, so they must be complete. Every row is
+// tried and reported on, and nothing is committed unless all of them go in.
+func (service Service) Import(format Format, r io.Reader, dryRun bool, claims model.Claims) (model.BulkReport, error) {
+	reader, err := NewReader(format, r)
+	if err != nil {
+		return model.BulkReport{}, errors.Wrap(err, "read file")
+	}
+
+	report := model.BulkReport{DryRun: dryRun, Errors: make(model.BulkRowErrors, 0)}
+
+	var records []Record
+	var numbers []int
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		report.Rows++
+		if errors.Is(err, ErrInvalidRow) {
+			report.Errors = append(report.Errors, model.BulkRowError{Row: number, Message: err.Error()})
+			continue
+		}
+		if err != nil {
+			return model.BulkReport{}, errors.Wrap(err, "read file")
+		}
+
+		if record.AuthorID == "" {
+			record.AuthorID = claims.ID
+		}
+		records = append(records, record)
+		numbers = append(numbers, number)
+	}
+
+	lookup, err := service.lookup(records)
+	if err != nil {
+		return model.BulkReport{}, err
+	}
+
+	var rows []Row
+	var rowNumbers []int
+	var rowNames []string
+	for index, record := range records {
+		request, err := lookup.request(record)
+		if err != nil {
+			report.Errors = append(report.Errors, model.BulkRowError{Row: numbers[index], Name: record.Name, Message: err.Error()})
+			continue
+		}
+
+		rows = append(rows, importRow(request, model.Claims{ID: record.AuthorID}, record.Status == model.RecipeStatusPublished))
+		rowNumbers = append(rowNumbers, numbers[index])
+		rowNames = append(rowNames, record.Name)
+	}
+
+	rowErrors, err := service.Repository.Import(rows, !dryRun && len(report.Errors) == 0)
+	if err != nil {
+		return model.BulkReport{}, errors.Wrap(err, "import recipes")
+	}
+
+	for index, err := range rowErrors {
+		if err != nil {
+			report.Errors = append(report.Errors, model.BulkRowError{Row: rowNumbers[index], Name: rowNames[index], Message: err.Error()})
+			continue
+		}
+		report.Imported++
+	}
+
+	report.Errors.Sort()
+	report.Committed = !dryRun && len(report.Errors) == 0
+
+	return report, nil
+}
+
+// importRow creates the recipe, then publishes it when the row was published.
+func importRow(request dto.FoodRecipeRequest, author model.Claims, publish bool) Row {
+	return func(recipes foodrecipe.IService) error {
+		recipe, err := recipes.Create(request, author)
+		if err != nil {
+			return err
+		}
+
+		if publish {
+			_, err = recipes.Publish(strconv.FormatUint(uint64(recipe.ID), 10), author)
+		}
+		return err
+	}
+}
+
+// lookup resolves the names in records to the IDs a request takes. Names
+// are matched regardless of case.
+type lookup struct {
+	difficulties map[string]uint
+	durations    map[string]uint
+	tags         map[string][]uint
+	users        map[string]bool
+}
+
+func key(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func (service Service) lookup(records []Record) (lookup, error) {
+	difficulties, err := service.Repository.GetDifficulties()
+	if err != nil {
+		return lookup{}, errors.Wrap(err, "get difficulties")
+	}
+
+	durations, err := service.Repository.GetCookingDurations()
+	if err != nil {
+		return lookup{}, errors.Wrap(err, "get cooking durations")
+	}
+
+	tags, err := service.Repository.GetTags()
+	if err != nil {
+		return lookup{}, errors.Wrap(err, "get tags")
+	}
+
+	var authorIDs []string
+	seen := make(map[string]bool)
+	for _, record := range records {
+		if record.AuthorID != "" && !seen[record.AuthorID] {
+			authorIDs = append(authorIDs, record.AuthorID)
+			seen[record.AuthorID] = true
+		}
+	}
+
+	userIDs, err := service.Repository.GetUserIDs(authorIDs)
+	if err != nil {
+		return lookup{}, errors.Wrap(err, "get users")
+	}
+
+	result := lookup{
+		difficulties: make(map[string]uint, len(difficulties)),
+		durations:    make(map[string]uint, len(durations)),
+		tags:         make(map[string][]uint, len(tags)),
+		users:        make(map[string]bool, len(userIDs)),
+	}
+	for _, difficulty := range difficulties {
+		result.difficulties[key(difficulty.Name)] = difficulty.ID
+	}
+	for _, duration := range durations {
+		result.durations[key(duration.Name)] = duration.ID
+	}
+	for _, tag := range tags {
+		result.tags[key(tag.Name)] = append(result.tags[key(tag.Name)], tag.ID)
+	}
+	for _, id := range userIDs {
+		result.users[id] = true
+	}
+
+	return result, nil
+}
+
+// request turns a record into the request creating it. What the recipe
+// service validates is left to it; request only reports what it cannot
+// resolve.
+func (lookup lookup) request(record Record) (dto.FoodRecipeRequest, error) {
+	if record.AuthorID == "" {
+		return dto.FoodRecipeRequest{}, errors.New("authorId is required")
+	}
+	if !lookup.users[record.AuthorID] {
+		return dto.FoodRecipeRequest{}, errors.Errorf("unknown author %q", record.AuthorID)
+	}
+
+	difficultyID, ok := lookup.difficulties[key(record.Difficulty)]
+	if !ok {
+		return dto.FoodRecipeRequest{}, errors.Errorf("unknown difficulty %q", record.Difficulty)
+	}
+
+	durationID, ok := lookup.durations[key(record.CookingDuration)]
+	if !ok {
+		return dto.FoodRecipeRequest{}, errors.Errorf("unknown cooking duration %q", record.CookingDuration)
+	}
+
+	request := dto.FoodRecipeRequest{
+		Name:              record.Name,
+		Description:       record.Description,
+		Ingredients:       make([]dto.RecipeIngredientRequest, 0, len(record.Ingredients)),
+		Steps:             make([]dto.RecipeStepRequest, 0, len(record.Steps)),
+		Servings:          record.Servings,
+		Status:            record.Status,
+		CookingDurationID: durationID,
+		DifficultyID:      difficultyID,
+	}
+
+	// Recipes are published once created, as their author would
+	if request.Status == model.RecipeStatusPublished {
+		request.Status = model.RecipeStatusDraft
+	}
+
+	if record.ImageURL != "" {
+		imageURL := record.ImageURL
+		request.ImageURL = &imageURL
+	}
+
+	for _, ingredient := range record.Ingredients {
+		if ingredient != (Ingredient{}) {
+			request.Ingredients = append(request.Ingredients, dto.RecipeIngredientRequest{
+				Name:     ingredient.Name,
+				Quantity: ingredient.Quantity,
+				Unit:     ingredient.Unit,
+				Note:     ingredient.Note,
+			})
+		}
+	}
+
+	for _, step := range record.Steps {
+		if strings.TrimSpace(step.Text) == "" {
+			continue
+		}
+
+		item := dto.RecipeStepRequest{Position: len(request.Steps) + 1, Text: step.Text, DurationSeconds: step.DurationSeconds}
+		if step.ImageURL != "" {
+			imageURL := step.ImageURL
+			item.ImageURL = &imageURL
+		}
+		request.Steps = append(request.Steps, item)
+	}
+
+	for _, name := range record.Tags {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+
+		ids := lookup.tags[key(name)]
+		switch {
+		case len(ids) == 0:
+			return dto.FoodRecipeRequest{}, errors.Errorf("unknown tag %q", name)
+		case len(ids) > 1:
+			return dto.FoodRecipeRequest{}, errors.Errorf("tag %q is in more than one group", name)
+		}
+		request.TagIDs = append(request.TagIDs, ids[0])
+	}
+
+	return request, nil
+}
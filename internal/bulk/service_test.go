@@ -0,0 +1,205 @@
+package bulk_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/bulk"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+const adminID = "admin"
+
+type ServiceImportTestSuite struct {
+	suite.Suite
+
+	service bulk.IService
+	repo    *MockIRepository
+	recipes *MockRecipeService
+
+	commit   bool
+	requests []dto.FoodRecipeRequest
+	authors  []string
+}
+
+func (suite *ServiceImportTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.recipes = new(MockRecipeService)
+	suite.service = &bulk.Service{Repository: suite.repo}
+	suite.requests = nil
+	suite.authors = nil
+
+	suite.repo.On("GetDifficulties").Return([]model.Difficulty{{Model: gorm.Model{ID: 1}, Name: "Easy"}, {Model: gorm.Model{ID: 2}, Name: "Hard"}}, nil)
+	suite.repo.On("GetCookingDurations").Return([]model.CookingDuration{{Model: gorm.Model{ID: 1}, Name: "5 - 10"}, {Model: gorm.Model{ID: 2}, Name: "11 - 30"}}, nil)
+	suite.repo.On("GetTags").Return(model.Tags{
+		{Model: gorm.Model{ID: 4}, Name: "Thai"},
+		{Model: gorm.Model{ID: 5}, Name: "Spicy", TagGroupID: 1},
+		{Model: gorm.Model{ID: 6}, Name: "Spicy", TagGroupID: 2},
+	}, nil)
+	suite.repo.On("GetUserIDs", mock.Anything).Return([]string{adminID, "somchai"}, nil)
+
+	// The rows run against the recipe service mock, as they would in the transaction
+	suite.repo.On("Import", mock.Anything, mock.Anything).Return(func(rows []bulk.Row, commit bool) ([]error, error) {
+		suite.commit = commit
+		errs := make([]error, len(rows))
+		for index, row := range rows {
+			errs[index] = row(suite.recipes)
+		}
+		return errs, nil
+	})
+
+	suite.recipes.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		suite.requests = append(suite.requests, args.Get(0).(dto.FoodRecipeRequest))
+		suite.authors = append(suite.authors, args.Get(1).(model.Claims).ID)
+	}).Return(model.FoodRecipe{Model: gorm.Model{ID: 9}}, nil)
+	suite.recipes.On("Publish", "9", mock.Anything).Return(model.FoodRecipe{}, nil)
+}
+
+func (suite *ServiceImportTestSuite) TestResolveNamesAndCommit() {
+	file := "name,difficulty,cookingDuration,tags,ingredients,steps,status,authorId\n" +
+		"Pad Kra Pao,hard,11 - 30,thai,\"300 g minced pork\n1 cup holy basil\",\"Fry.\n\nServe.\",published,somchai\n" +
+		"Rice,Easy,5 - 10,,rice,Cook.,,\n"
+
+	report, err := suite.service.Import(bulk.CSV, strings.NewReader(file), false, model.Claims{ID: adminID})
+	suite.NoError(err)
+
+	suite.Equal(model.BulkReport{Rows: 2, Imported: 2, Committed: true, Errors: model.BulkRowErrors{}}, report)
+	suite.True(suite.commit)
+
+	suite.Require().Len(suite.requests, 2)
+	suite.Equal(uint(2), suite.requests[0].DifficultyID)
+	suite.Equal(uint(2), suite.requests[0].CookingDurationID)
+	suite.Equal([]uint{4}, suite.requests[0].TagIDs)
+	suite.Equal(model.RecipeStatusDraft, suite.requests[0].Status)
+	suite.Equal("minced pork", suite.requests[0].Ingredients[0].Name)
+	suite.Equal([]dto.RecipeStepRequest{{Position: 1, Text: "Fry."}, {Position: 2, Text: "Serve."}}, suite.requests[0].Steps)
+	suite.Equal([]string{"somchai", adminID}, suite.authors)
+
+	suite.recipes.AssertNumberOfCalls(suite.T(), "Publish", 1)
+}
+
+func (suite *ServiceImportTestSuite) TestImportWhatWasExported() {
+	recipe := padKraPao()
+	recipe.Tags = model.Tags{{Name: "Thai"}}
+
+	for _, format := range []bulk.Format{bulk.CSV, bulk.JSONL} {
+		suite.requests = nil
+
+		var buffer bytes.Buffer
+		writer, err := bulk.NewWriter(format, &buffer)
+		suite.Require().NoError(err)
+		suite.Require().NoError(writer.Write(bulk.FromRecipe(recipe)))
+		suite.Require().NoError(writer.Flush())
+
+		_, err = suite.service.Import(format, &buffer, false, model.Claims{ID: adminID})
+		suite.Require().NoError(err)
+
+		suite.Require().Len(suite.requests, 1, format)
+		suite.Equal(recipe.Ingredients.ToRequest(), suite.requests[0].Ingredients, format)
+		suite.Equal(recipe.Steps.ToRequest(), suite.requests[0].Steps, format)
+	}
+}
+
+func (suite *ServiceImportTestSuite) TestReportRowErrorsAndRollBack() {
+	file := `{"name":"Rice","difficulty":"Easy","cookingDuration":"5 - 10"}
+{"name":"Soup","difficulty":"Medium","cookingDuration":"5 - 10"}
+{broken
+{"name":"Curry","difficulty":"Easy","cookingDuration":"5 - 10","tags":["Spicy"]}
+{"name":"Omelette","difficulty":"Easy","cookingDuration":"5 - 10","authorId":"nobody"}
+`
+
+	report, err := suite.service.Import(bulk.JSONL, strings.NewReader(file), false, model.Claims{ID: adminID})
+	suite.NoError(err)
+
+	suite.Equal(5, report.Rows)
+	suite.Equal(1, report.Imported)
+	suite.False(report.Committed)
+	suite.False(suite.commit)
+
+	suite.Require().Len(report.Errors, 4)
+	suite.Equal(model.BulkRowError{Row: 2, Name: "Soup", Message: `unknown difficulty "Medium"`}, report.Errors[0])
+	suite.Equal(3, report.Errors[1].Row)
+	suite.Equal(model.BulkRowError{Row: 4, Name: "Curry", Message: `tag "Spicy" is in more than one group`}, report.Errors[2])
+	suite.Equal(model.BulkRowError{Row: 5, Name: "Omelette", Message: `unknown author "nobody"`}, report.Errors[3])
+}
+
+func (suite *ServiceImportTestSuite) TestReportErrorOfRecipeService() {
+	suite.recipes.ExpectedCalls = nil
+	suite.recipes.On("Create", mock.Anything, mock.Anything).Return(model.FoodRecipe{}, errors.New("request invalid"))
+
+	file := "name,difficulty,cookingDuration\n,Easy,5 - 10\n"
+
+	report, err := suite.service.Import(bulk.CSV, strings.NewReader(file), false, model.Claims{ID: adminID})
+	suite.NoError(err)
+
+	suite.Equal(model.BulkRowErrors{{Row: 1, Message: "request invalid"}}, report.Errors)
+	suite.False(report.Committed)
+}
+
+func (suite *ServiceImportTestSuite) TestDryRunNeverCommits() {
+	file := "name,difficulty,cookingDuration\nRice,Easy,5 - 10\n"
+
+	report, err := suite.service.Import(bulk.CSV, strings.NewReader(file), true, model.Claims{ID: adminID})
+	suite.NoError(err)
+
+	suite.Equal(model.BulkReport{Rows: 1, Imported: 1, DryRun: true, Errors: model.BulkRowErrors{}}, report)
+	suite.False(suite.commit)
+}
+
+func (suite *ServiceImportTestSuite) TestInvalidHeader() {
+	_, err := suite.service.Import(bulk.CSV, strings.NewReader("name\nRice\n"), false, model.Claims{ID: adminID})
+	suite.ErrorIs(err, bulk.ErrInvalidHeader)
+
+	suite.repo.AssertNotCalled(suite.T(), "Import", mock.Anything, mock.Anything)
+}
+
+func TestServiceImport(t *testing.T) {
+	suite.Run(t, new(ServiceImportTestSuite))
+}
+
+type ServiceExportTestSuite struct {
+	suite.Suite
+
+	service bulk.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceExportTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &bulk.Service{Repository: suite.repo}
+
+	suite.repo.On("Each", bulk.BatchSize, mock.Anything).Run(func(args mock.Arguments) {
+		fn := args.Get(1).(func(model.FoodRecipes) error)
+		suite.Require().NoError(fn(model.FoodRecipes{padKraPao()}))
+		suite.Require().NoError(fn(model.FoodRecipes{{Name: "Rice"}}))
+	}).Return(nil)
+}
+
+func (suite *ServiceExportTestSuite) TestWriteEveryBatch() {
+	var buffer bytes.Buffer
+	count, err := suite.service.Export(bulk.JSONL, &buffer)
+	suite.NoError(err)
+
+	suite.Equal(2, count)
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	suite.Require().Len(lines, 2)
+	suite.Contains(lines[0], `"ratingCount":3,"averageRating":4.67`)
+	suite.Contains(lines[1], `"name":"Rice"`)
+}
+
+func (suite *ServiceExportTestSuite) TestUnknownFormat() {
+	_, err := suite.service.Export("xlsx", &bytes.Buffer{})
+	suite.ErrorIs(err, bulk.ErrUnknownFormat)
+
+	suite.repo.AssertNotCalled(suite.T(), "Each", mock.Anything, mock.Anything)
+}
+
+func TestServiceExport(t *testing.T) {
+	suite.Run(t, new(ServiceExportTestSuite))
+}
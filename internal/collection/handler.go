@@ -0,0 +1,231 @@
+package collection
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	GetRecipes(ctx *gin.Context)
+	AddRecipe(ctx *gin.Context)
+	RemoveRecipe(ctx *gin.Context)
+	Reorder(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) IHandler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+func (handler Handler) Get(ctx *gin.Context) {
+	var query model.CollectionQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, _ := helper.DecodeClaims(ctx)
+
+	collections, total, cursors, err := handler.Service.Get(query, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	response := collections.ToResponse(total)
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (handler Handler) GetByID(ctx *gin.Context) {
+	claims, _ := helper.DecodeClaims(ctx)
+
+	collection, err := handler.Service.GetByID(ctx.Param("id"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collection.ToResponse())
+}
+
+func (handler Handler) Create(ctx *gin.Context) {
+	var request dto.CollectionRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	collection, err := handler.Service.Create(request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, collection.ToResponse())
+}
+
+func (handler Handler) Update(ctx *gin.Context) {
+	var request dto.CollectionRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	collection, err := handler.Service.Update(request, ctx.Param("id"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collection.ToResponse())
+}
+
+func (handler Handler) Delete(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(ctx.Param("id"), claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Collection deleted successfully"})
+}
+
+func (handler Handler) GetRecipes(ctx *gin.Context) {
+	var pageQuery model.PageQuery
+	if err := ctx.ShouldBindQuery(&pageQuery); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, _ := helper.DecodeClaims(ctx)
+
+	recipes, total, cursors, err := handler.Service.GetRecipes(ctx.Param("id"), pageQuery, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	response := recipes.ToResponse(total)
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (handler Handler) AddRecipe(ctx *gin.Context) {
+	var request dto.CollectionRecipeRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	collection, err := handler.Service.AddRecipe(ctx.Param("id"), request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collection.ToResponse())
+}
+
+func (handler Handler) RemoveRecipe(ctx *gin.Context) {
+	recipeID, err := strconv.ParseUint(ctx.Param("recipeId"), 10, 0)
+	if err != nil || recipeID == 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid recipe ID"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	collection, err := handler.Service.RemoveRecipe(ctx.Param("id"), uint(recipeID), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collection.ToResponse())
+}
+
+func (handler Handler) Reorder(ctx *gin.Context) {
+	var request dto.CollectionOrderRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	collection, err := handler.Service.Reorder(ctx.Param("id"), request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collection.ToResponse())
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}),
+		errors.Is(err, global.ErrorInvalidCursor),
+		errors.Is(err, global.ErrorInvalidOrder):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrorForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,255 @@
+package collection
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	Get(query model.CollectionQuery, viewerID string) (model.Collections, pagination.Cursors, error)
+	Count(query model.CollectionQuery, viewerID string) (int64, error)
+	GetByID(id string, viewerID string) (model.Collection, error)
+	Create(collection *model.Collection) error
+	Update(collection *model.Collection) error
+	Delete(id uint) error
+	GetRecipe(id uint) (model.FoodRecipe, error)
+	GetRecipes(collectionID uint, viewerID string, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)
+	GetRecipeIDs(collectionID uint, viewerID string) ([]uint, error)
+	AddRecipe(collectionID uint, recipeID uint) error
+	RemoveRecipe(collectionID uint, recipeID uint) error
+	Reorder(collectionID uint, recipeIDs []uint) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// visibleCollections keeps the public collections and the viewer's own.
+func visibleCollections(viewerID string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(
+			"(collections.visibility = ? OR collections.user_id = ?)",
+			model.CollectionVisibilityPublic, viewerID,
+		)
+	}
+}
+
+// liveRecipes keeps the recipes that are not deleted, so recipes removed from
+// the site drop out of every collection without touching its entries.
+const liveRecipes = "JOIN food_recipes ON food_recipes.id = collection_recipes.food_recipe_id AND food_recipes.deleted_at IS NULL"
+
+// visibleRecipes keeps the recipes the viewer may open, see
+// model.FoodRecipe.VisibleTo. A public collection does not open the drafts or
+// private recipes of others.
+func visibleRecipes(viewerID string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(
+			"(food_recipes.status IN ? OR food_recipes.user_id = ?)",
+			[]string{model.RecipeStatusPublished, model.RecipeStatusUnlisted}, viewerID,
+		)
+	}
+}
+
+// withRecipeCount selects collections with the number of recipes the viewer
+// sees in them.
+func (repo Repository) withRecipeCount(viewerID string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		count := repo.DB.Table("collection_recipes").
+			Select("COUNT(*)").
+			Joins(liveRecipes).
+			Scopes(visibleRecipes(viewerID)).
+			Where("collection_recipes.collection_id = collections.id")
+
+		return db.Select("collections.*, (?) AS recipe_count", count)
+	}
+}
+
+func filterCollections(query model.CollectionQuery, viewerID string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Scopes(visibleCollections(viewerID))
+		if query.UserID != "" {
+			db = db.Where("collections.user_id = ?", query.UserID)
+		}
+		return db
+	}
+}
+
+// Get lists the collections the viewer may open, newest first.
+func (repo Repository) Get(query model.CollectionQuery, viewerID string) (model.Collections, pagination.Cursors, error) {
+	var collections = make(model.Collections, 0)
+
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	limit := query.Size(pagination.DefaultLimit)
+	order := pagination.Order{Table: "collections", Desc: true}
+
+	err = repo.DB.Preload("User").
+		Scopes(
+			repo.withRecipeCount(viewerID),
+			filterCollections(query, viewerID),
+			pagination.Scope(order, cursor, limit, query.Offset(limit)),
+		).
+		Find(&collections).Error
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	collections, cursors := pagination.Paginate(collections, limit, cursor, query.Offset(limit) > 0, func(collection model.Collection) pagination.Cursor {
+		return pagination.Cursor{ID: collection.ID}
+	})
+	return collections, cursors, nil
+}
+
+func (repo Repository) Count(query model.CollectionQuery, viewerID string) (int64, error) {
+	var count int64
+	err := repo.DB.Model(&model.Collection{}).Scopes(filterCollections(query, viewerID)).Count(&count).Error
+	return count, err
+}
+
+// GetByID returns a collection whatever its visibility, counting the recipes
+// the viewer sees in it.
+func (repo Repository) GetByID(id string, viewerID string) (model.Collection, error) {
+	var collection model.Collection
+	err := repo.DB.Preload("User").
+		Scopes(repo.withRecipeCount(viewerID)).
+		First(&collection, "collections.id = ?", id).Error
+	return collection, err
+}
+
+func (repo Repository) Create(collection *model.Collection) error {
+	if err := repo.DB.Omit("User").Create(collection).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("User").First(collection, collection.ID).Error
+}
+
+func (repo Repository) Update(collection *model.Collection) error {
+	return repo.DB.Omit("User").Save(collection).Error
+}
+
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Delete(&model.Collection{}, id).Error
+}
+
+func (repo Repository) GetRecipe(id uint) (model.FoodRecipe, error) {
+	var recipe model.FoodRecipe
+	err := repo.DB.First(&recipe, id).Error
+	return recipe, err
+}
+
+// GetRecipes returns the recipes of a collection the viewer sees, in the
+// order the owner arranged them.
+func (repo Repository) GetRecipes(collectionID uint, viewerID string, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	limit := query.Size(pagination.DefaultLimit)
+	order := pagination.Order{Table: "food_recipes", SQL: "collection_recipes.position", Type: "INT"}
+
+	err = repo.DB.Joins("JOIN collection_recipes ON collection_recipes.food_recipe_id = food_recipes.id AND collection_recipes.collection_id = ?", collectionID).
+		Scopes(visibleRecipes(viewerID), pagination.Scope(order, cursor, limit, query.Offset(limit))).
+		Preload(clause.Associations).
+		Find(&recipes).Error
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	recipes, cursors := pagination.Paginate(recipes, limit, cursor, query.Offset(limit) > 0, func(recipe model.FoodRecipe) pagination.Cursor {
+		return pagination.Cursor{Key: recipe.SortKey, ID: recipe.ID}
+	})
+	return recipes, cursors, nil
+}
+
+// GetRecipeIDs returns the recipes of a collection the viewer may open, in
+// order. These are what a reorder has to list.
+func (repo Repository) GetRecipeIDs(collectionID uint, viewerID string) ([]uint, error) {
+	var ids = make([]uint, 0)
+	err := repo.DB.Table("collection_recipes").
+		Joins(liveRecipes).
+		Scopes(visibleRecipes(viewerID)).
+		Where("collection_recipes.collection_id = ?", collectionID).
+		Order("collection_recipes.position asc").
+		Pluck("collection_recipes.food_recipe_id", &ids).Error
+	return ids, err
+}
+
+// AddRecipe puts a recipe at the end of a collection. Adding it again keeps
+// its place.
+func (repo Repository) AddRecipe(collectionID uint, recipeID uint) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		var last int
+		if err := tx.Model(&model.CollectionRecipe{}).
+			Where("collection_id = ?", collectionID).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&last).Error; err != nil {
+			return err
+		}
+
+		entry := model.CollectionRecipe{CollectionID: collectionID, FoodRecipeID: recipeID, Position: last + 1}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entry).Error
+	})
+}
+
+func (repo Repository) RemoveRecipe(collectionID uint, recipeID uint) error {
+	result := repo.DB.Where("collection_id = ? AND food_recipe_id = ?", collectionID, recipeID).
+		Delete(&model.CollectionRecipe{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Reorder puts the listed recipes in the places the listed recipes take up,
+// in the order given, and numbers every entry from one. Entries left out,
+// recipes that were deleted or are hidden from the owner, keep their places,
+// in case the recipes come back.
+func (repo Repository) Reorder(collectionID uint, recipeIDs []uint) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		var entries []uint
+		if err := tx.Model(&model.CollectionRecipe{}).
+			Where("collection_id = ?", collectionID).
+			Order("position asc").
+			Pluck("food_recipe_id", &entries).Error; err != nil {
+			return err
+		}
+
+		listed := make(map[uint]bool, len(recipeIDs))
+		for _, id := range recipeIDs {
+			listed[id] = true
+		}
+
+		next := 0
+		for index, id := range entries {
+			if listed[id] {
+				id = recipeIDs[next]
+				next++
+			}
+
+			if err := tx.Model(&model.CollectionRecipe{}).
+				Where("collection_id = ? AND food_recipe_id = ?", collectionID, id).
+				Update("position", index+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
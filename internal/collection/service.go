@@ -0,0 +1,226 @@
+package collection
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.CollectionQuery, claims model.Claims) (model.Collections, int64, pagination.Cursors, error)
+	GetByID(id string, claims model.Claims) (model.Collection, error)
+	Create(request dto.CollectionRequest, claims model.Claims) (model.Collection, error)
+	Update(request dto.CollectionRequest, id string, claims model.Claims) (model.Collection, error)
+	Delete(id string, claims model.Claims) error
+	GetRecipes(id string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)
+	AddRecipe(id string, request dto.CollectionRecipeRequest, claims model.Claims) (model.Collection, error)
+	RemoveRecipe(id string, recipeID uint, claims model.Claims) (model.Collection, error)
+	Reorder(id string, request dto.CollectionOrderRequest, claims model.Claims) (model.Collection, error)
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) Get(query model.CollectionQuery, claims model.Claims) (model.Collections, int64, pagination.Cursors, error) {
+	total, err := service.Repository.Count(query, claims.ID)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "count collections")
+	}
+
+	collections, cursors, err := service.Repository.Get(query, claims.ID)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "get collections")
+	}
+
+	return collections, total, cursors, nil
+}
+
+// GetByID returns a collection the caller may open. A private collection of
+// someone else is reported as missing, so its existence does not leak.
+func (service Service) GetByID(id string, claims model.Claims) (model.Collection, error) {
+	collection, err := service.Repository.GetByID(id, claims.ID)
+	if err != nil {
+		return model.Collection{}, errors.Wrap(err, "find collection")
+	}
+
+	if !collection.VisibleTo(claims.ID) {
+		return model.Collection{}, errors.Wrap(gorm.ErrRecordNotFound, "find collection")
+	}
+
+	return collection, nil
+}
+
+// findOwned returns the collection when the caller owns it.
+func (service Service) findOwned(id string, claims model.Claims) (model.Collection, error) {
+	collection, err := service.GetByID(id, claims)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	if collection.UserID != claims.ID {
+		return model.Collection{}, global.ErrorForbidden
+	}
+
+	return collection, nil
+}
+
+func (service Service) Create(request dto.CollectionRequest, claims model.Claims) (model.Collection, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Collection{}, errors.Wrap(err, "request invalid")
+	}
+
+	var collection model.Collection
+	collection = collection.FromRequest(request, claims)
+
+	if err := service.Repository.Create(&collection); err != nil {
+		return model.Collection{}, errors.Wrap(err, "create collection")
+	}
+
+	return collection, nil
+}
+
+func (service Service) Update(request dto.CollectionRequest, id string, claims model.Claims) (model.Collection, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Collection{}, errors.Wrap(err, "request invalid")
+	}
+
+	collection, err := service.findOwned(id, claims)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	collection = collection.FromRequest(request, claims)
+
+	if err := service.Repository.Update(&collection); err != nil {
+		return model.Collection{}, errors.Wrap(err, "update collection")
+	}
+
+	return collection, nil
+}
+
+func (service Service) Delete(id string, claims model.Claims) error {
+	collection, err := service.findOwned(id, claims)
+	if err != nil {
+		return err
+	}
+
+	return service.Repository.Delete(collection.ID)
+}
+
+func (service Service) GetRecipes(id string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error) {
+	collection, err := service.GetByID(id, claims)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, err
+	}
+
+	recipes, cursors, err := service.Repository.GetRecipes(collection.ID, claims.ID, query)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "get collection recipes")
+	}
+
+	return recipes, collection.RecipeCount, cursors, nil
+}
+
+// AddRecipe appends a recipe the owner may open to their collection.
+func (service Service) AddRecipe(id string, request dto.CollectionRecipeRequest, claims model.Claims) (model.Collection, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Collection{}, errors.Wrap(err, "request invalid")
+	}
+
+	collection, err := service.findOwned(id, claims)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	recipe, err := service.Repository.GetRecipe(request.RecipeID)
+	if err != nil {
+		return model.Collection{}, errors.Wrap(err, "find recipe")
+	}
+
+	if !recipe.VisibleTo(claims.ID) {
+		return model.Collection{}, errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+	}
+
+	if err := service.Repository.AddRecipe(collection.ID, recipe.ID); err != nil {
+		return model.Collection{}, errors.Wrap(err, "add recipe")
+	}
+
+	return service.GetByID(id, claims)
+}
+
+func (service Service) RemoveRecipe(id string, recipeID uint, claims model.Claims) (model.Collection, error) {
+	collection, err := service.findOwned(id, claims)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	if err := service.Repository.RemoveRecipe(collection.ID, recipeID); err != nil {
+		return model.Collection{}, errors.Wrap(err, "remove recipe")
+	}
+
+	return service.GetByID(id, claims)
+}
+
+// Reorder arranges the recipes of a collection. The request has to list every
+// recipe the owner can see exactly once, so a stale client cannot drop
+// recipes by leaving them out. Recipes hidden from the owner keep their
+// places.
+func (service Service) Reorder(id string, request dto.CollectionOrderRequest, claims model.Claims) (model.Collection, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Collection{}, errors.Wrap(err, "request invalid")
+	}
+
+	collection, err := service.findOwned(id, claims)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	current, err := service.Repository.GetRecipeIDs(collection.ID, claims.ID)
+	if err != nil {
+		return model.Collection{}, errors.Wrap(err, "get collection recipes")
+	}
+
+	if !sameRecipes(current, request.RecipeIDs) {
+		return model.Collection{}, global.ErrorInvalidOrder
+	}
+
+	if err := service.Repository.Reorder(collection.ID, request.RecipeIDs); err != nil {
+		return model.Collection{}, errors.Wrap(err, "reorder collection")
+	}
+
+	return collection, nil
+}
+
+// sameRecipes tells whether both lists hold the same recipes, each once.
+func sameRecipes(current []uint, requested []uint) bool {
+	if len(current) != len(requested) {
+		return false
+	}
+
+	seen := make(map[uint]bool, len(current))
+	for _, id := range current {
+		seen[id] = true
+	}
+	for _, id := range requested {
+		if !seen[id] {
+			return false
+		}
+		delete(seen, id)
+	}
+	return true
+}
@@ -0,0 +1,178 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/collection"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+const (
+	ownerID    = "owner"
+	strangerID = "stranger"
+)
+
+type ServiceGetByIDTestSuite struct {
+	suite.Suite
+
+	service collection.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceGetByIDTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &collection.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetByID", "1", mock.Anything).Return(model.Collection{Model: gorm.Model{ID: 1}, UserID: ownerID, Visibility: model.CollectionVisibilityPublic}, nil)
+	suite.repo.On("GetByID", "2", mock.Anything).Return(model.Collection{Model: gorm.Model{ID: 2}, UserID: ownerID, Visibility: model.CollectionVisibilityPrivate}, nil)
+}
+
+func (suite *ServiceGetByIDTestSuite) TestReturnPublicCollectionToAnyone() {
+	found, err := suite.service.GetByID("1", model.Claims{})
+	suite.NoError(err)
+
+	suite.Equal(uint(1), found.ID)
+}
+
+func (suite *ServiceGetByIDTestSuite) TestReturnPrivateCollectionToOwner() {
+	found, err := suite.service.GetByID("2", model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal(uint(2), found.ID)
+}
+
+func (suite *ServiceGetByIDTestSuite) TestNotFoundWhenPrivateCollectionOfSomeoneElse() {
+	_, err := suite.service.GetByID("2", model.Claims{ID: strangerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ServiceGetByIDTestSuite) TestForbiddenWhenUpdatingCollectionOfSomeoneElse() {
+	_, err := suite.service.Update(dto.CollectionRequest{Title: "Mine now"}, "1", model.Claims{ID: strangerID})
+	suite.ErrorIs(err, global.ErrorForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func TestServiceGetByID(t *testing.T) {
+	suite.Run(t, new(ServiceGetByIDTestSuite))
+}
+
+type ServiceAddRecipeTestSuite struct {
+	suite.Suite
+
+	service collection.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceAddRecipeTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &collection.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetByID", "1", mock.Anything).Return(model.Collection{Model: gorm.Model{ID: 1}, UserID: ownerID}, nil)
+	suite.repo.On("GetRecipe", uint(10)).Return(model.FoodRecipe{Model: gorm.Model{ID: 10}, Status: model.RecipeStatusPublished, UserID: strangerID}, nil)
+	suite.repo.On("GetRecipe", uint(11)).Return(model.FoodRecipe{Model: gorm.Model{ID: 11}, Status: model.RecipeStatusDraft, UserID: strangerID}, nil)
+	suite.repo.On("GetRecipe", mock.Anything).Return(model.FoodRecipe{}, gorm.ErrRecordNotFound)
+	suite.repo.On("AddRecipe", mock.Anything, mock.Anything).Return(nil)
+}
+
+func (suite *ServiceAddRecipeTestSuite) TestAddPublishedRecipe() {
+	_, err := suite.service.AddRecipe("1", dto.CollectionRecipeRequest{RecipeID: 10}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "AddRecipe", uint(1), uint(10))
+}
+
+func (suite *ServiceAddRecipeTestSuite) TestNotFoundWhenDraftOfSomeoneElse() {
+	_, err := suite.service.AddRecipe("1", dto.CollectionRecipeRequest{RecipeID: 11}, model.Claims{ID: ownerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "AddRecipe", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceAddRecipeTestSuite) TestNotFoundWhenRecipeDeleted() {
+	_, err := suite.service.AddRecipe("1", dto.CollectionRecipeRequest{RecipeID: 12}, model.Claims{ID: ownerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "AddRecipe", mock.Anything, mock.Anything)
+}
+
+func TestServiceAddRecipe(t *testing.T) {
+	suite.Run(t, new(ServiceAddRecipeTestSuite))
+}
+
+type ServiceReorderTestSuite struct {
+	suite.Suite
+
+	service collection.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceReorderTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &collection.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetByID", "1", mock.Anything).Return(model.Collection{Model: gorm.Model{ID: 1}, UserID: ownerID}, nil)
+	suite.repo.On("GetRecipeIDs", uint(1), ownerID).Return([]uint{10, 11, 12}, nil)
+	suite.repo.On("Reorder", mock.Anything, mock.Anything).Return(nil)
+}
+
+func (suite *ServiceReorderTestSuite) TestReorderRecipes() {
+	_, err := suite.service.Reorder("1", dto.CollectionOrderRequest{RecipeIDs: []uint{12, 10, 11}}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Reorder", uint(1), []uint{12, 10, 11})
+}
+
+func (suite *ServiceReorderTestSuite) TestErrorWhenRecipeLeftOut() {
+	_, err := suite.service.Reorder("1", dto.CollectionOrderRequest{RecipeIDs: []uint{12, 10}}, model.Claims{ID: ownerID})
+	suite.ErrorIs(err, global.ErrorInvalidOrder)
+
+	suite.repo.AssertNotCalled(suite.T(), "Reorder", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceReorderTestSuite) TestErrorWhenRecipeNotInCollection() {
+	_, err := suite.service.Reorder("1", dto.CollectionOrderRequest{RecipeIDs: []uint{12, 10, 13}}, model.Claims{ID: ownerID})
+	suite.ErrorIs(err, global.ErrorInvalidOrder)
+
+	suite.repo.AssertNotCalled(suite.T(), "Reorder", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceReorderTestSuite) TestErrorWhenRecipeRepeated() {
+	_, err := suite.service.Reorder("1", dto.CollectionOrderRequest{RecipeIDs: []uint{12, 10, 10}}, model.Claims{ID: ownerID})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.repo.AssertNotCalled(suite.T(), "Reorder", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceReorderTestSuite) TestReorderEmptyCollection() {
+	suite.repo.On("GetByID", "3", mock.Anything).Return(model.Collection{Model: gorm.Model{ID: 3}, UserID: ownerID}, nil)
+	suite.repo.On("GetRecipeIDs", uint(3), ownerID).Return([]uint{}, nil)
+
+	_, err := suite.service.Reorder("3", dto.CollectionOrderRequest{RecipeIDs: []uint{}}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Reorder", uint(3), []uint{})
+}
+
+func (suite *ServiceReorderTestSuite) TestForbiddenWhenNotOwner() {
+	suite.repo.On("GetByID", "2", mock.Anything).Return(model.Collection{Model: gorm.Model{ID: 2}, UserID: strangerID, Visibility: model.CollectionVisibilityPublic}, nil)
+
+	_, err := suite.service.Reorder("2", dto.CollectionOrderRequest{RecipeIDs: []uint{}}, model.Claims{ID: ownerID})
+	suite.ErrorIs(err, global.ErrorForbidden)
+}
+
+func TestServiceReorder(t *testing.T) {
+	suite.Run(t, new(ServiceReorderTestSuite))
+}
@@ -0,0 +1,147 @@
+package comment
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+	GetReplies(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) IHandler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+func (handler Handler) Get(ctx *gin.Context) {
+	var query model.PageQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, _ := helper.DecodeClaims(ctx)
+
+	comments, cursors, err := handler.Service.Get(ctx.Param("id"), query, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	response := comments.ToResponse()
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (handler Handler) GetReplies(ctx *gin.Context) {
+	var query model.PageQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, _ := helper.DecodeClaims(ctx)
+
+	comments, cursors, err := handler.Service.GetReplies(ctx.Param("id"), ctx.Param("commentId"), query, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	response := comments.ToResponse()
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (handler Handler) Create(ctx *gin.Context) {
+	var request dto.CommentRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	comment, err := handler.Service.Create(request, ctx.Param("id"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, comment.ToResponse())
+}
+
+func (handler Handler) Update(ctx *gin.Context) {
+	var request dto.CommentRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	comment, err := handler.Service.Update(request, ctx.Param("id"), ctx.Param("commentId"), claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment.ToResponse())
+}
+
+func (handler Handler) Delete(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(ctx.Param("id"), ctx.Param("commentId"), claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Comment deleted successfully"})
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}), errors.Is(err, global.ErrorInvalidCursor),
+		errors.Is(err, ErrReplyWithScore):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrorForbidden), errors.Is(err, global.ErrorOwnRecipe),
+		errors.Is(err, ErrEditWindowClosed):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,183 @@
+package comment
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/klins/devpool/go-day6/wongnok/internal/rating"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	Get(recipeID uint, query model.PageQuery) (model.Comments, pagination.Cursors, error)
+	GetReplies(recipeID uint, parentID string, query model.PageQuery) (model.Comments, pagination.Cursors, error)
+	GetByID(id string) (model.Comment, error)
+	Create(comment *model.Comment) error
+	Update(comment *model.Comment) error
+	Delete(comment model.Comment) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+func preloadAuthor(db *gorm.DB) *gorm.DB {
+	return db.Preload("User").Preload("Rating")
+}
+
+func position(comment model.Comment) pagination.Cursor {
+	return pagination.Cursor{ID: comment.ID}
+}
+
+// Get returns the threads of a recipe, newest first, with the number of
+// replies of each. A deleted comment that still has replies stays as the head
+// of its thread, so the replies are not left without one.
+func (repo Repository) Get(recipeID uint, query model.PageQuery) (model.Comments, pagination.Cursors, error) {
+	var comments model.Comments
+
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	limit := query.Size(pagination.DefaultLimit)
+	order := pagination.Order{Table: "comments", Desc: true}
+
+	err = repo.DB.Unscoped().Scopes(preloadAuthor, pagination.Scope(order, cursor, limit, query.Offset(limit))).
+		Select("comments.*, (?) AS reply_count", liveReplies(repo.DB, "COUNT(*)")).
+		Where("comments.food_recipe_id = ? AND comments.parent_id IS NULL", recipeID).
+		Where("comments.deleted_at IS NULL OR EXISTS (?)", liveReplies(repo.DB, "1")).
+		Find(&comments).Error
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	comments, cursors := pagination.Paginate(comments, limit, cursor, query.Offset(limit) > 0, position)
+	return comments, cursors, nil
+}
+
+// liveReplies selects columns of the live replies of the comment the outer
+// query is on.
+func liveReplies(db *gorm.DB, columns string) *gorm.DB {
+	return db.Table("comments AS replies").
+		Select(columns).
+		Where("replies.parent_id = comments.id AND replies.deleted_at IS NULL")
+}
+
+// GetReplies returns the live replies of a thread, oldest first, as a
+// conversation reads.
+func (repo Repository) GetReplies(recipeID uint, parentID string, query model.PageQuery) (model.Comments, pagination.Cursors, error) {
+	var comments model.Comments
+
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	limit := query.Size(pagination.DefaultLimit)
+	order := pagination.Order{Table: "comments"}
+
+	err = repo.DB.Scopes(preloadAuthor, pagination.Scope(order, cursor, limit, query.Offset(limit))).
+		Where("comments.food_recipe_id = ? AND comments.parent_id = ?", recipeID, parentID).
+		Find(&comments).Error
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	comments, cursors := pagination.Paginate(comments, limit, cursor, query.Offset(limit) > 0, position)
+	return comments, cursors, nil
+}
+
+func (repo Repository) GetByID(id string) (model.Comment, error) {
+	var comment model.Comment
+	err := repo.DB.Scopes(preloadAuthor).First(&comment, id).Error
+	return comment, err
+}
+
+// Create writes the comment with the rating of a review, and counts it on the
+// recipe.
+func (repo Repository) Create(comment *model.Comment) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := saveRating(tx, comment); err != nil {
+			return err
+		}
+
+		if err := tx.Omit(clause.Associations).Create(comment).Error; err != nil {
+			return err
+		}
+
+		if err := count(tx, comment.FoodRecipeID, 1); err != nil {
+			return err
+		}
+
+		return tx.Scopes(preloadAuthor).First(comment, comment.ID).Error
+	})
+}
+
+// Update writes the body and the score, the only parts an author may edit.
+func (repo Repository) Update(comment *model.Comment) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := saveRating(tx, comment); err != nil {
+			return err
+		}
+
+		err := tx.Model(comment).Omit(clause.Associations).
+			Select("body", "rating_id", "edited_at").
+			Updates(comment).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Scopes(preloadAuthor).First(comment, comment.ID).Error
+	})
+}
+
+// Delete soft deletes the comment and the rating of a review. Its replies
+// stay. Deleting twice counts once.
+func (repo Repository) Delete(comment model.Comment) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		deleted := tx.Delete(&model.Comment{}, comment.ID)
+		if deleted.Error != nil || deleted.RowsAffected == 0 {
+			return deleted.Error
+		}
+
+		if comment.Rating != nil {
+			if err := rating.NewRepository(tx).Delete(*comment.Rating); err != nil {
+				return err
+			}
+		}
+
+		return count(tx, comment.FoodRecipeID, -1)
+	})
+}
+
+// saveRating writes the score of a review before the comment pointing at it.
+// A user has one rating per recipe, so a review takes over the rating the
+// author may have given before.
+func saveRating(tx *gorm.DB, comment *model.Comment) error {
+	// A rating read with the comment is saved already
+	if comment.Rating == nil || comment.Rating.ID != 0 {
+		return nil
+	}
+
+	if _, err := rating.NewRepository(tx).Upsert(comment.Rating); err != nil {
+		return err
+	}
+
+	comment.RatingID = &comment.Rating.ID
+	return nil
+}
+
+// count moves the comment count of a recipe without touching when the recipe
+// was last updated.
+func count(tx *gorm.DB, recipeID uint, delta int) error {
+	return tx.Table("food_recipes").
+		Where("id = ?", recipeID).
+		UpdateColumn("comment_count", gorm.Expr("comment_count + ?", delta)).Error
+}
@@ -0,0 +1,222 @@
+package comment
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// EditWindow is how long after writing a comment its author may still edit
+// it. Later changes would change what replies answered.
+const EditWindow = 15 * time.Minute
+
+var (
+	ErrEditWindowClosed = errors.New("comment can no longer be edited")
+	ErrReplyWithScore   = errors.New("only a review may carry a score, not a reply")
+)
+
+type IService interface {
+	Get(recipeID string, query model.PageQuery, claims model.Claims) (model.Comments, pagination.Cursors, error)
+	GetReplies(recipeID string, id string, query model.PageQuery, claims model.Claims) (model.Comments, pagination.Cursors, error)
+	Create(request dto.CommentRequest, recipeID string, claims model.Claims) (model.Comment, error)
+	Update(request dto.CommentRequest, recipeID string, id string, claims model.Claims) (model.Comment, error)
+	Delete(recipeID string, id string, claims model.Claims) error
+}
+
+type Service struct {
+	Repository IRepository
+	Recipes    foodrecipe.IRepository
+	Now        func() time.Time
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+		Recipes:    foodrecipe.NewRepository(db),
+		Now:        time.Now,
+	}
+}
+
+// findRecipe returns the recipe when the caller may see it, and with it its
+// comments. Otherwise it is reported as missing.
+func (service Service) findRecipe(id string, claims model.Claims) (model.FoodRecipe, error) {
+	recipe, err := service.Recipes.GetByID(id)
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "find recipe")
+	}
+
+	if !recipe.VisibleTo(claims.ID) {
+		return model.FoodRecipe{}, errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+	}
+
+	return recipe, nil
+}
+
+// findComment returns a live comment of a recipe the caller may see, and the
+// recipe.
+func (service Service) findComment(recipeID string, id string, claims model.Claims) (model.FoodRecipe, model.Comment, error) {
+	recipe, err := service.findRecipe(recipeID, claims)
+	if err != nil {
+		return model.FoodRecipe{}, model.Comment{}, err
+	}
+
+	comment, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.FoodRecipe{}, model.Comment{}, errors.Wrap(err, "find comment")
+	}
+
+	if comment.FoodRecipeID != recipe.ID {
+		return model.FoodRecipe{}, model.Comment{}, errors.Wrap(gorm.ErrRecordNotFound, "find comment")
+	}
+
+	return recipe, comment, nil
+}
+
+func (service Service) Get(recipeID string, query model.PageQuery, claims model.Claims) (model.Comments, pagination.Cursors, error) {
+	recipe, err := service.findRecipe(recipeID, claims)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	comments, cursors, err := service.Repository.Get(recipe.ID, query)
+	if err != nil {
+		return nil, pagination.Cursors{}, errors.Wrap(err, "get comments")
+	}
+
+	return comments, cursors, nil
+}
+
+func (service Service) GetReplies(recipeID string, id string, query model.PageQuery, claims model.Claims) (model.Comments, pagination.Cursors, error) {
+	recipe, err := service.findRecipe(recipeID, claims)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	comments, cursors, err := service.Repository.GetReplies(recipe.ID, id, query)
+	if err != nil {
+		return nil, pagination.Cursors{}, errors.Wrap(err, "get replies")
+	}
+
+	return comments, cursors, nil
+}
+
+// Create starts a thread, or replies in one. A reply to a reply goes to the
+// thread the replied comment is in.
+func (service Service) Create(request dto.CommentRequest, recipeID string, claims model.Claims) (model.Comment, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Comment{}, errors.Wrap(err, "request invalid")
+	}
+
+	if request.ParentID != nil && request.Score != nil {
+		return model.Comment{}, ErrReplyWithScore
+	}
+
+	recipe, err := service.findRecipe(recipeID, claims)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	if request.Score != nil && recipe.UserID == claims.ID {
+		return model.Comment{}, global.ErrorOwnRecipe
+	}
+
+	comment := model.Comment{FoodRecipeID: recipe.ID}
+
+	if request.ParentID != nil {
+		_, parent, err := service.findComment(recipeID, strconv.FormatUint(uint64(*request.ParentID), 10), claims)
+		if err != nil {
+			return model.Comment{}, errors.Wrap(err, "find parent")
+		}
+
+		comment.ParentID = &parent.ID
+		if parent.ParentID != nil {
+			comment.ParentID = parent.ParentID
+		}
+	}
+
+	if request.Score != nil {
+		rating := model.Rating{}.FromRequest(dto.RatingRequest{Score: *request.Score})
+		rating.FoodRecipeID, rating.UserID = recipe.ID, claims.ID
+		comment.Rating = &rating
+	}
+
+	comment = comment.FromRequest(request, claims)
+	if err := service.Repository.Create(&comment); err != nil {
+		return model.Comment{}, errors.Wrap(err, "create comment")
+	}
+
+	return comment, nil
+}
+
+// Update lets the author rewrite a comment within the edit window. A review
+// may change its score; leaving the score out keeps it.
+func (service Service) Update(request dto.CommentRequest, recipeID string, id string, claims model.Claims) (model.Comment, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Comment{}, errors.Wrap(err, "request invalid")
+	}
+
+	recipe, comment, err := service.findComment(recipeID, id, claims)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	if comment.UserID != claims.ID {
+		return model.Comment{}, global.ErrorForbidden
+	}
+
+	now := service.Now()
+	if now.Sub(comment.CreatedAt) > EditWindow {
+		return model.Comment{}, ErrEditWindowClosed
+	}
+
+	if request.Score != nil {
+		if comment.ParentID != nil {
+			return model.Comment{}, ErrReplyWithScore
+		}
+
+		if recipe.UserID == claims.ID {
+			return model.Comment{}, global.ErrorOwnRecipe
+		}
+
+		rating := model.Rating{}.FromRequest(dto.RatingRequest{Score: *request.Score})
+		rating.FoodRecipeID, rating.UserID = recipe.ID, claims.ID
+		comment.Rating = &rating
+	}
+
+	comment = comment.FromRequest(request, claims)
+	comment.EditedAt = &now
+
+	if err := service.Repository.Update(&comment); err != nil {
+		return model.Comment{}, errors.Wrap(err, "update comment")
+	}
+
+	return comment, nil
+}
+
+// Delete lets the author or a moderator remove a comment.
+func (service Service) Delete(recipeID string, id string, claims model.Claims) error {
+	_, comment, err := service.findComment(recipeID, id, claims)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != claims.ID && !claims.CanModerate() {
+		return global.ErrorForbidden
+	}
+
+	if err := service.Repository.Delete(comment); err != nil {
+		return errors.Wrap(err, "delete comment")
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package comment_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/comment"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+const (
+	authorID   = "author"
+	ownerID    = "owner"
+	strangerID = "stranger"
+)
+
+var now = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+func score(value float64) *float64 {
+	return &value
+}
+
+func commentID(value uint) *uint {
+	return &value
+}
+
+type ServiceTestSuite struct {
+	suite.Suite
+
+	service comment.IService
+	repo    *MockIRepository
+	recipes *MockRecipeRepository
+}
+
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.recipes = new(MockRecipeRepository)
+	suite.service = &comment.Service{
+		Repository: suite.repo,
+		Recipes:    suite.recipes,
+		Now:        func() time.Time { return now },
+	}
+
+	suite.recipes.On("GetByID", "1").Return(model.FoodRecipe{Model: gorm.Model{ID: 1}, Status: model.RecipeStatusPublished, UserID: authorID}, nil)
+	suite.recipes.On("GetByID", "2").Return(model.FoodRecipe{Model: gorm.Model{ID: 2}, Status: model.RecipeStatusDraft, UserID: authorID}, nil)
+
+	suite.repo.On("GetByID", "10").Return(model.Comment{
+		Model:        gorm.Model{ID: 10, CreatedAt: now.Add(-5 * time.Minute)},
+		FoodRecipeID: 1,
+		UserID:       ownerID,
+		Body:         "Lovely",
+		RatingID:     commentID(4),
+		Rating:       &model.Rating{Model: gorm.Model{ID: 4}, Score: 4},
+	}, nil)
+	suite.repo.On("GetByID", "11").Return(model.Comment{
+		Model:        gorm.Model{ID: 11, CreatedAt: now.Add(-time.Hour)},
+		FoodRecipeID: 1,
+		UserID:       strangerID,
+		ParentID:     commentID(10),
+		Body:         "Agreed",
+	}, nil)
+	suite.repo.On("GetByID", "12").Return(model.Comment{Model: gorm.Model{ID: 12}, FoodRecipeID: 2}, nil)
+	suite.repo.On("Create", mock.Anything).Return(nil)
+	suite.repo.On("Update", mock.Anything).Return(nil)
+	suite.repo.On("Delete", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceTestSuite) TestCreateReview() {
+	created, err := suite.service.Create(dto.CommentRequest{Body: " So good ", Score: score(5)}, "1", model.Claims{ID: strangerID})
+	suite.NoError(err)
+
+	suite.Equal("So good", created.Body)
+	suite.Equal(uint(1), created.FoodRecipeID)
+	suite.Equal(strangerID, created.UserID)
+	suite.Equal(&model.Rating{Score: 5, FoodRecipeID: 1, UserID: strangerID}, created.Rating)
+	suite.Nil(created.ParentID)
+}
+
+func (suite *ServiceTestSuite) TestAuthorCannotReviewOwnRecipe() {
+	_, err := suite.service.Create(dto.CommentRequest{Body: "Best ever", Score: score(5)}, "1", model.Claims{ID: authorID})
+	suite.ErrorIs(err, global.ErrorOwnRecipe)
+
+	created, err := suite.service.Create(dto.CommentRequest{Body: "Thank you!", ParentID: commentID(10)}, "1", model.Claims{ID: authorID})
+	suite.NoError(err)
+	suite.Equal(commentID(10), created.ParentID)
+}
+
+func (suite *ServiceTestSuite) TestReplyToReplyGoesToThread() {
+	created, err := suite.service.Create(dto.CommentRequest{Body: "Thanks", ParentID: commentID(11)}, "1", model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal(commentID(10), created.ParentID)
+	suite.Nil(created.Rating)
+}
+
+func (suite *ServiceTestSuite) TestReplyCannotCarryScore() {
+	_, err := suite.service.Create(dto.CommentRequest{Body: "Thanks", ParentID: commentID(10), Score: score(3)}, "1", model.Claims{ID: ownerID})
+	suite.ErrorIs(err, comment.ErrReplyWithScore)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestReplyToCommentOfOtherRecipe() {
+	_, err := suite.service.Create(dto.CommentRequest{Body: "Thanks", ParentID: commentID(12)}, "1", model.Claims{ID: ownerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ServiceTestSuite) TestCommentOnDraftOfSomeoneElse() {
+	_, err := suite.service.Create(dto.CommentRequest{Body: "First"}, "2", model.Claims{ID: strangerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	_, _, err = suite.service.Get("2", model.PageQuery{}, model.Claims{})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ServiceTestSuite) TestEditWithinWindow() {
+	updated, err := suite.service.Update(dto.CommentRequest{Body: "Lovely, again", Score: score(5)}, "1", "10", model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal("Lovely, again", updated.Body)
+	suite.Equal(&model.Rating{Score: 5, FoodRecipeID: 1, UserID: ownerID}, updated.Rating)
+	suite.Equal(&now, updated.EditedAt)
+}
+
+func (suite *ServiceTestSuite) TestEditKeepsScoreLeftOut() {
+	updated, err := suite.service.Update(dto.CommentRequest{Body: "Lovely, again"}, "1", "10", model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal(uint(4), updated.Rating.ID)
+	suite.Equal(4.0, updated.Rating.Score)
+}
+
+func (suite *ServiceTestSuite) TestEditAfterWindow() {
+	_, err := suite.service.Update(dto.CommentRequest{Body: "Changed my mind"}, "1", "11", model.Claims{ID: strangerID})
+	suite.ErrorIs(err, comment.ErrEditWindowClosed)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceTestSuite) TestEditCommentOfSomeoneElse() {
+	_, err := suite.service.Update(dto.CommentRequest{Body: "Mine now"}, "1", "10", model.Claims{ID: strangerID})
+	suite.ErrorIs(err, global.ErrorForbidden)
+}
+
+func (suite *ServiceTestSuite) TestDeleteOwnComment() {
+	suite.NoError(suite.service.Delete("1", "11", model.Claims{ID: strangerID}))
+
+	suite.repo.AssertCalled(suite.T(), "Delete", mock.MatchedBy(func(deleted model.Comment) bool { return deleted.ID == 11 }))
+}
+
+func (suite *ServiceTestSuite) TestModeratorDeletesCommentOfSomeoneElse() {
+	for _, role := range []string{model.RoleModerator, model.RoleAdmin} {
+		claims := model.Claims{ID: "moderator", RealmAccess: model.RealmAccess{Roles: []string{role}}}
+		suite.NoError(suite.service.Delete("1", "10", claims), role)
+	}
+
+	suite.repo.AssertNumberOfCalls(suite.T(), "Delete", 2)
+}
+
+func (suite *ServiceTestSuite) TestDeleteCommentOfSomeoneElse() {
+	err := suite.service.Delete("1", "10", model.Claims{ID: strangerID})
+	suite.ErrorIs(err, global.ErrorForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func TestService(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
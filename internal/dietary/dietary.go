@@ -0,0 +1,152 @@
+// Package dietary labels recipes with dietary flags and allergens from their
+// ingredient names, using the keyword rules in rules.csv.
+//
+// Labels are stored on each recipe when it is saved, so recipes have to be
+// relabelled (cmd/relabel) after the rules change.
+package dietary
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+)
+
+//go:embed rules.csv
+var rulesCSV []byte
+
+type Diet string
+
+const (
+	Vegetarian    Diet = "vegetarian"
+	Vegan         Diet = "vegan"
+	HalalFriendly Diet = "halal-friendly"
+	GlutenFree    Diet = "gluten-free"
+)
+
+type Allergen string
+
+const (
+	Peanut    Allergen = "peanut"
+	Shellfish Allergen = "shellfish"
+	FishSauce Allergen = "fish-sauce"
+	Egg       Allergen = "egg"
+	Dairy     Allergen = "dairy"
+)
+
+// Allergens lists every allergen the rules can detect, sorted by name.
+var Allergens = []Allergen{Dairy, Egg, FishSauce, Peanut, Shellfish}
+
+// rule marks the ingredients containing keyword. Rules without effects exist
+// to shadow shorter keywords, so "eggplant" is not an egg and "กะทิ" is not
+// milk.
+type rule struct {
+	keyword   string
+	pattern   *regexp.Regexp
+	breaks    []Diet
+	allergens []Allergen
+}
+
+var rules = mustLoad(rulesCSV)
+
+// Analysis is what the rules found in a list of ingredients.
+type Analysis struct {
+	broken    map[Diet]bool
+	allergens map[Allergen]bool
+}
+
+// Allows reports whether no ingredient breaks the diet.
+func (analysis Analysis) Allows(diet Diet) bool {
+	return !analysis.broken[diet]
+}
+
+func (analysis Analysis) Contains(allergen Allergen) bool {
+	return analysis.allergens[allergen]
+}
+
+// Analyze runs the rules over the words of the ingredient names. Keywords are
+// tried longest first and a matched keyword is blanked out, so it cannot match
+// again as part of a shorter one.
+func Analyze(ingredients []string) Analysis {
+	analysis := Analysis{
+		broken:    make(map[Diet]bool),
+		allergens: make(map[Allergen]bool),
+	}
+
+	for _, ingredient := range ingredients {
+		name := " " + strings.Join(search.Tokenize(ingredient), " ") + " "
+
+		for _, rule := range rules {
+			if !rule.pattern.MatchString(name) {
+				continue
+			}
+			name = rule.pattern.ReplaceAllString(name, " ")
+
+			for _, diet := range rule.breaks {
+				analysis.broken[diet] = true
+			}
+			for _, allergen := range rule.allergens {
+				analysis.allergens[allergen] = true
+			}
+		}
+	}
+
+	// Anything that is not vegetarian is not vegan either
+	if analysis.broken[Vegetarian] {
+		analysis.broken[Vegan] = true
+	}
+
+	return analysis
+}
+
+func mustLoad(data []byte) []rule {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		panic("dietary: read rules.csv: " + err.Error())
+	}
+
+	results := make([]rule, 0, len(records))
+	for _, record := range records[1:] {
+		keyword := strings.ToLower(record[0])
+		rule := rule{keyword: keyword, pattern: compile(keyword)}
+		for _, diet := range split(record[1]) {
+			rule.breaks = append(rule.breaks, Diet(diet))
+		}
+		for _, allergen := range split(record[2]) {
+			rule.allergens = append(rule.allergens, Allergen(allergen))
+		}
+		results = append(results, rule)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return len(results[i].keyword) > len(results[j].keyword)
+	})
+
+	return results
+}
+
+// compile matches keywords as whole words, English plurals included, so
+// "rum" does not match "drumstick". Thai keywords are split into words the
+// way the names are, so "ไก่" matches "ไก่ย่าง" but not "ไข่ไก่", which has a
+// rule of its own.
+func compile(keyword string) *regexp.Regexp {
+	for _, r := range keyword {
+		if r > unicode.MaxASCII {
+			words := strings.Join(search.Tokenize(keyword), " ")
+			return regexp.MustCompile(` ` + regexp.QuoteMeta(words) + ` `)
+		}
+	}
+	return regexp.MustCompile(`\b` + regexp.QuoteMeta(keyword) + `(?:e?s)?\b`)
+}
+
+func split(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, "|")
+}
@@ -0,0 +1,95 @@
+package dietary_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/dietary"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	t.Run("ShouldAllowEverythingForPlainVegetables", func(t *testing.T) {
+		analysis := dietary.Analyze([]string{"มะเขือยาว", "Eggplant", "กะทิ", "Rice flour"})
+
+		for _, diet := range []dietary.Diet{dietary.Vegetarian, dietary.Vegan, dietary.HalalFriendly, dietary.GlutenFree} {
+			assert.True(t, analysis.Allows(diet), diet)
+		}
+		for _, allergen := range dietary.Allergens {
+			assert.False(t, analysis.Contains(allergen), allergen)
+		}
+	})
+
+	t.Run("ShouldDetectThaiIngredients", func(t *testing.T) {
+		analysis := dietary.Analyze([]string{"หมูสับ", "น้ำปลา", "ไข่ไก่", "ถั่วลิสงคั่ว"})
+
+		assert.False(t, analysis.Allows(dietary.Vegetarian))
+		assert.False(t, analysis.Allows(dietary.HalalFriendly))
+		assert.True(t, analysis.Allows(dietary.GlutenFree))
+		assert.True(t, analysis.Contains(dietary.FishSauce))
+		assert.True(t, analysis.Contains(dietary.Egg))
+		assert.True(t, analysis.Contains(dietary.Peanut))
+		assert.False(t, analysis.Contains(dietary.Shellfish))
+	})
+
+	t.Run("ShouldLetVegetarianDishKeepDairyAndEgg", func(t *testing.T) {
+		analysis := dietary.Analyze([]string{"Eggs", "Butter", "Wheat flour"})
+
+		assert.True(t, analysis.Allows(dietary.Vegetarian))
+		assert.False(t, analysis.Allows(dietary.Vegan))
+		assert.False(t, analysis.Allows(dietary.GlutenFree))
+		assert.True(t, analysis.Contains(dietary.Dairy))
+	})
+
+	t.Run("ShouldPreferLongestKeyword", func(t *testing.T) {
+		analysis := dietary.Analyze([]string{"peanut butter"})
+
+		assert.True(t, analysis.Contains(dietary.Peanut))
+		assert.False(t, analysis.Contains(dietary.Dairy))
+		assert.True(t, analysis.Allows(dietary.Vegan))
+	})
+
+	t.Run("ShouldMatchEnglishWholeWordsOnly", func(t *testing.T) {
+		analysis := dietary.Analyze([]string{"drumsticks", "champignons"})
+
+		assert.True(t, analysis.Allows(dietary.HalalFriendly))
+		assert.True(t, analysis.Allows(dietary.Vegetarian))
+	})
+
+	t.Run("ShouldNotTreatVegetarianBreakerAsVegan", func(t *testing.T) {
+		analysis := dietary.Analyze([]string{"Chicken"})
+
+		assert.False(t, analysis.Allows(dietary.Vegan))
+	})
+}
+
+func TestAnalyzeCompounds(t *testing.T) {
+	cases := map[string]struct {
+		ingredient string
+		vegetarian bool
+		vegan      bool
+		allergens  []dietary.Allergen
+	}{
+		"ShouldKeepChickenEggVegetarian": {ingredient: "ไข่ไก่", vegetarian: true, allergens: []dietary.Allergen{dietary.Egg}},
+		"ShouldKeepDuckEggVegetarian":    {ingredient: "ไข่เป็ด", vegetarian: true, allergens: []dietary.Allergen{dietary.Egg}},
+		"ShouldKeepCoconutFleshVegan":    {ingredient: "เนื้อมะพร้าว", vegetarian: true, vegan: true},
+		"ShouldDetectDairyInButtermilk":  {ingredient: "buttermilk", vegetarian: true, allergens: []dietary.Allergen{dietary.Dairy}},
+		"ShouldKeepAlmondMilkVegan":      {ingredient: "almond milk", vegetarian: true, vegan: true},
+		"ShouldKeepCoconutMilkVegan":     {ingredient: "coconut milk", vegetarian: true, vegan: true},
+		"ShouldKeepSoyMilkVegan":         {ingredient: "นมถั่วเหลือง", vegetarian: true, vegan: true},
+		"ShouldNotReadMilkInBread":       {ingredient: "ขนมปัง", vegetarian: true, vegan: true},
+		"ShouldStillDetectThaiMeat":      {ingredient: "เนื้อวัว"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			analysis := dietary.Analyze([]string{tc.ingredient})
+
+			assert.Equal(t, tc.vegetarian, analysis.Allows(dietary.Vegetarian))
+			assert.Equal(t, tc.vegan, analysis.Allows(dietary.Vegan))
+			for _, allergen := range dietary.Allergens {
+				assert.Equal(t, slices.Contains(tc.allergens, allergen), analysis.Contains(allergen), allergen)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package foodrecipe
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/units"
+)
+
+// ConvertUnits returns a copy of the recipe with every structured ingredient
+// written in the given unit system. Ingredients in units the system has no
+// equivalent for, such as "ฟอง" or "clove", are left as written.
+func ConvertUnits(recipe model.FoodRecipe, system units.System) model.FoodRecipe {
+	if system == "" {
+		return recipe
+	}
+
+	ingredients := make(model.RecipeIngredients, len(recipe.Ingredients))
+	copy(ingredients, recipe.Ingredients)
+
+	for index, ingredient := range ingredients {
+		if ingredient.Quantity == nil {
+			continue
+		}
+
+		quantity, unit, ok := units.ToSystem(*ingredient.Quantity, ingredient.Unit, system)
+		if !ok {
+			continue
+		}
+
+		quantity = RoundQuantity(quantity)
+		ingredients[index].Quantity = &quantity
+		ingredients[index].Unit = unit
+	}
+
+	recipe.Ingredients = ingredients
+
+	return recipe
+}
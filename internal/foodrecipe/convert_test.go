@@ -0,0 +1,41 @@
+package foodrecipe_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/units"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertUnits(t *testing.T) {
+	sauce, eggs := 2.0, 3.0
+
+	recipe := model.FoodRecipe{
+		Ingredients: model.RecipeIngredients{
+			{Name: "น้ำปลา", Quantity: &sauce, Unit: "ช้อนโต๊ะ"},
+			{Name: "ไข่ไก่", Quantity: &eggs, Unit: "ฟอง"},
+		},
+	}
+
+	t.Run("ShouldConvertKnownUnits", func(t *testing.T) {
+		converted := foodrecipe.ConvertUnits(recipe, units.Metric)
+
+		assert.Equal(t, 30.0, *converted.Ingredients[0].Quantity)
+		assert.Equal(t, "ml", converted.Ingredients[0].Unit)
+	})
+
+	t.Run("ShouldKeepUnknownUnits", func(t *testing.T) {
+		converted := foodrecipe.ConvertUnits(recipe, units.US)
+
+		assert.Equal(t, 3.0, *converted.Ingredients[1].Quantity)
+		assert.Equal(t, "ฟอง", converted.Ingredients[1].Unit)
+	})
+
+	t.Run("ShouldNotModifyOriginalRecipe", func(t *testing.T) {
+		foodrecipe.ConvertUnits(recipe, units.US)
+
+		assert.Equal(t, "ช้อนโต๊ะ", recipe.Ingredients[0].Unit)
+	})
+}
@@ -0,0 +1,53 @@
+package foodrecipe
+
+import (
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/dietary"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+)
+
+// LabelDietary derives the dietary flags and allergens of a recipe from its
+// ingredients and applies the author's overrides on top. Recipes without
+// structured ingredients are labelled from the lines of the legacy text.
+func LabelDietary(recipe model.FoodRecipe) model.FoodRecipe {
+	names := make([]string, 0, len(recipe.Ingredients))
+	for _, ingredient := range recipe.Ingredients {
+		names = append(names, ingredient.Name)
+	}
+	if len(names) == 0 {
+		names = strings.Split(recipe.Ingredient, "\n")
+	}
+
+	analysis := dietary.Analyze(names)
+
+	recipe.Dietary = recipe.DietaryOverrides.Apply(model.DietaryFlags{
+		Vegetarian:    analysis.Allows(dietary.Vegetarian),
+		Vegan:         analysis.Allows(dietary.Vegan),
+		HalalFriendly: analysis.Allows(dietary.HalalFriendly),
+		GlutenFree:    analysis.Allows(dietary.GlutenFree),
+	})
+
+	overrides := recipe.Allergens.Overrides()
+	allergens := make(model.RecipeAllergens, 0)
+	for _, allergen := range dietary.Allergens {
+		detected := analysis.Contains(allergen)
+		override, overridden := overrides[string(allergen)]
+		if !detected && !overridden {
+			continue
+		}
+
+		labelled := model.RecipeAllergen{
+			FoodRecipeID: recipe.ID,
+			Allergen:     string(allergen),
+			Detected:     detected,
+		}
+		if overridden {
+			labelled.Override = &override
+		}
+		allergens = append(allergens, labelled)
+	}
+	recipe.Allergens = allergens
+
+	return recipe
+}
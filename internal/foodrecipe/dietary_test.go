@@ -0,0 +1,49 @@
+package foodrecipe_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelDietary(t *testing.T) {
+	yes, no := true, false
+
+	t.Run("ShouldDeriveFlagsAndAllergensFromIngredients", func(t *testing.T) {
+		recipe := foodrecipe.LabelDietary(model.FoodRecipe{
+			Ingredients: model.RecipeIngredients{
+				{Name: "ไข่ไก่"},
+				{Name: "น้ำปลา"},
+			},
+		})
+
+		assert.Equal(t, model.DietaryFlags{HalalFriendly: true, GlutenFree: true}, recipe.Dietary)
+		assert.Equal(t, []string{"egg", "fish-sauce"}, recipe.Allergens.Present())
+	})
+
+	t.Run("ShouldFallBackToLegacyIngredientText", func(t *testing.T) {
+		recipe := foodrecipe.LabelDietary(model.FoodRecipe{Ingredient: "2 eggs\nmilk"})
+
+		assert.Equal(t, []string{"dairy", "egg"}, recipe.Allergens.Present())
+	})
+
+	t.Run("ShouldApplyAuthorOverrides", func(t *testing.T) {
+		recipe := foodrecipe.LabelDietary(model.FoodRecipe{
+			Ingredients: model.RecipeIngredients{
+				{Name: "น้ำปลาเจ"},
+			},
+			DietaryOverrides: model.DietaryOverrides{Vegetarian: &yes, Vegan: &yes},
+			Allergens: model.RecipeAllergens{
+				{Allergen: "fish-sauce", Override: &no},
+				{Allergen: "peanut", Override: &yes},
+			},
+		})
+
+		assert.True(t, recipe.Dietary.Vegetarian)
+		assert.True(t, recipe.Dietary.Vegan)
+		assert.Equal(t, []string{"peanut"}, recipe.Allergens.Present())
+		assert.Equal(t, map[string]bool{"fish-sauce": false, "peanut": true}, recipe.Allergens.Overrides())
+	})
+}
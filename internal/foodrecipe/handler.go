@@ -1,6 +1,7 @@
 package foodrecipe
 
 import (
+	"io"
 	"net/http"
 	"strconv"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/render"
+	"github.com/klins/devpool/go-day6/wongnok/internal/schemaorg"
+	"github.com/klins/devpool/go-day6/wongnok/internal/units"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -18,9 +22,18 @@ type IHandler interface {
 	Create(ctx *gin.Context)
 	Get(ctx *gin.Context)
 	GetByID(ctx *gin.Context)
+	GetScaled(ctx *gin.Context)
 	Update(ctx *gin.Context)
 	Delete(ctx *gin.Context)
 	GetFavorites(ctx *gin.Context)
+	GetRevisions(ctx *gin.Context)
+	GetRevision(ctx *gin.Context)
+	DiffRevisions(ctx *gin.Context)
+	Revert(ctx *gin.Context)
+	Publish(ctx *gin.Context)
+	Fork(ctx *gin.Context)
+	GetForks(ctx *gin.Context)
+	Import(ctx *gin.Context)
 }
 
 type Handler struct {
@@ -49,8 +62,14 @@ func (handler Handler) Create(ctx *gin.Context) {
 
 	recipe, err := handler.Service.Create(request, claims)
 	if err != nil {
+		var incomplete IncompleteError
+		if errors.As(err, &incomplete) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "missing": incomplete.Missing})
+			return
+		}
+
 		statusCode := http.StatusInternalServerError
-		if errors.As(err, &validator.ValidationErrors{}) {
+		if errors.As(err, &validator.ValidationErrors{}) || errors.Is(err, global.ErrorUnknownTag) {
 			statusCode = http.StatusBadRequest
 		}
 
@@ -68,7 +87,22 @@ func (handler Handler) GetByID(ctx *gin.Context) {
 		return
 	}
 
-	recipe, err := handler.Service.GetByID(id)
+	system, err := bindUnits(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	format, err := bindFormat(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	// Anonymous visitors have no claims and only see public recipes
+	claims, _ := helper.DecodeClaims(ctx)
+
+	recipe, err := handler.Service.GetByID(id, claims)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, gin.H{"message": "Recipe not found"})
@@ -78,7 +112,47 @@ func (handler Handler) GetByID(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, recipe.ToResponse())
+	respondRecipe(ctx, ConvertUnits(recipe, system), format)
+}
+
+func (handler Handler) GetScaled(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "ID is required"})
+		return
+	}
+
+	var scaleQuery model.FoodRecipeScaleQuery
+	if err := ctx.ShouldBindQuery(&scaleQuery); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	system, err := bindUnits(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	format, err := bindFormat(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, _ := helper.DecodeClaims(ctx)
+
+	recipe, err := handler.Service.GetScaled(id, scaleQuery.Servings, claims)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Recipe not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	respondRecipe(ctx, ConvertUnits(recipe, system), format)
 }
 
 func (handler Handler) GetAll(ctx *gin.Context) {
@@ -102,13 +176,30 @@ func (handler Handler) Get(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
 		return
 	}
-	recipes, total, err := handler.Service.Get(foodRecipeQuery)
+	system, err := bindUnits(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipes, total, cursors, err := handler.Service.Get(foodRecipeQuery)
+	if err != nil {
+		ctx.JSON(listStatusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	facets, err := handler.Service.Facets(foodRecipeQuery)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, recipes.ToResponse(total))
+	response := convertAllUnits(recipes, system).ToResponse(total)
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+	facetsResponse := facets.ToResponse()
+	response.Facets = &facetsResponse
+
+	ctx.JSON(http.StatusOK, response)
 }
 
 func (handler Handler) Update(ctx *gin.Context) {
@@ -132,6 +223,12 @@ func (handler Handler) Update(ctx *gin.Context) {
 
 	recipe, err := handler.Service.Update(request, id, claims)
 	if err != nil {
+		var incomplete IncompleteError
+		if errors.As(err, &incomplete) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "missing": incomplete.Missing})
+			return
+		}
+
 		if errors.Is(err, global.ErrorNotFound) {
 			ctx.JSON(http.StatusNotFound, gin.H{"message": "Recipe not found"})
 			return
@@ -142,6 +239,11 @@ func (handler Handler) Update(ctx *gin.Context) {
 			return
 		}
 
+		if errors.As(err, &validator.ValidationErrors{}) || errors.Is(err, global.ErrorUnknownTag) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
@@ -192,11 +294,301 @@ func (handler Handler) GetFavorites(ctx *gin.Context) {
 		return
 	}
 
-	recipes, total, err := handler.Service.GetFavorites(foodRecipeQuery, claims)
+	system, err := bindUnits(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipes, total, cursors, err := handler.Service.GetFavorites(foodRecipeQuery, claims)
+	if err != nil {
+		ctx.JSON(listStatusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	response := convertAllUnits(recipes, system).ToResponse(total)
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// listStatusCode maps an error of a recipe listing to its status code. A
+// cursor the server did not hand out is a bad request.
+func listStatusCode(err error) int {
+	if errors.Is(err, global.ErrorInvalidCursor) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func (handler Handler) GetRevisions(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	revisions, err := handler.Service.GetRevisions(ctx.Param("id"), claims)
+	if err != nil {
+		ctx.JSON(revisionStatusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revisions.ToResponse())
+}
+
+func (handler Handler) GetRevision(ctx *gin.Context) {
+	number, err := strconv.Atoi(ctx.Param("rev"))
+	if err != nil || number <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid revision"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	revision, err := handler.Service.GetRevision(ctx.Param("id"), number, claims)
+	if err != nil {
+		ctx.JSON(revisionStatusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revision.ToResponse(true))
+}
+
+func (handler Handler) DiffRevisions(ctx *gin.Context) {
+	var diffQuery model.RevisionDiffQuery
+	if err := ctx.ShouldBindQuery(&diffQuery); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	diff, err := handler.Service.DiffRevisions(ctx.Param("id"), diffQuery.From, diffQuery.To, claims)
+	if err != nil {
+		ctx.JSON(revisionStatusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, diff.ToResponse())
+}
+
+func (handler Handler) Revert(ctx *gin.Context) {
+	number, err := strconv.Atoi(ctx.Param("rev"))
+	if err != nil || number <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid revision"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipe, err := handler.Service.Revert(ctx.Param("id"), number, claims)
+	if err != nil {
+		ctx.JSON(revisionStatusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, recipe.ToResponse())
+}
+
+func (handler Handler) Publish(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipe, err := handler.Service.Publish(ctx.Param("id"), claims)
+	if err != nil {
+		var incomplete IncompleteError
+		if errors.As(err, &incomplete) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error(), "missing": incomplete.Missing})
+			return
+		}
+
+		ctx.JSON(revisionStatusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, recipe.ToResponse())
+}
+
+func (handler Handler) Fork(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipe, err := handler.Service.Fork(ctx.Param("id"), claims)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Recipe not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, recipe.ToResponse())
+}
+
+func (handler Handler) GetForks(ctx *gin.Context) {
+	var pageQuery model.PageQuery
+	if err := ctx.ShouldBindQuery(&pageQuery); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, _ := helper.DecodeClaims(ctx)
+
+	forks, total, cursors, err := handler.Service.GetForks(ctx.Param("id"), pageQuery, claims)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Recipe not found"})
+			return
+		}
+		ctx.JSON(listStatusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	response := forks.ToResponse(total)
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Import creates a draft from the schema.org recipe in the uploaded file, an
+// HTML page or a JSON-LD document.
+func (handler Handler) Import(ctx *gin.Context) {
+	header, err := helper.FormFile(ctx, "file", MaxImportSize)
+	if errors.Is(err, global.ErrorTooLarge) {
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"message": "File is too large"})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	document, err := io.ReadAll(io.LimitReader(file, MaxImportSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipe, err := handler.Service.Import(document, claims)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, schemaorg.ErrNoRecipe) || errors.Is(err, schemaorg.ErrInvalidDocument) ||
+			errors.As(err, &validator.ValidationErrors{}) {
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, recipe.ToResponse())
+}
+
+// revisionStatusCode maps an error of the revision endpoints to its status
+// code. Reverting runs the update validation, so a revision that no longer
+// validates, say one with a deleted tag, is a bad request.
+func revisionStatusCode(err error) int {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, global.ErrorForbidden):
+		return http.StatusForbidden
+	case errors.As(err, &validator.ValidationErrors{}), errors.Is(err, global.ErrorUnknownTag),
+		errors.As(err, &IncompleteError{}):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// bindUnits reads the optional ?units= query shared by the recipe GET endpoints.
+func bindUnits(ctx *gin.Context) (units.System, error) {
+	var unitsQuery model.UnitsQuery
+	if err := ctx.ShouldBindQuery(&unitsQuery); err != nil {
+		return "", err
+	}
+
+	return units.System(unitsQuery.Units), nil
+}
+
+// bindFormat reads the optional ?format= query shared by the recipe GET
+// endpoints, falling back to the Accept header. The empty format is the JSON
+// of the API.
+func bindFormat(ctx *gin.Context) (render.Format, error) {
+	var formatQuery model.FormatQuery
+	if err := ctx.ShouldBindQuery(&formatQuery); err != nil {
+		return "", err
+	}
+
+	if formatQuery.Format == "json" {
+		return "", nil
+	}
+	if formatQuery.Format != "" {
+		return render.Format(formatQuery.Format), nil
+	}
+
+	ctx.Header("Vary", "Accept")
+
+	offered := []string{gin.MIMEJSON}
+	for _, format := range render.Formats {
+		offered = append(offered, format.MediaType())
+	}
+
+	format, _ := render.ForMediaType(ctx.NegotiateFormat(offered...))
+	return format, nil
+}
+
+// respondRecipe answers with the recipe in the format.
+func respondRecipe(ctx *gin.Context, recipe model.FoodRecipe, format render.Format) {
+	if format == "" {
+		ctx.JSON(http.StatusOK, recipe.ToResponse())
+		return
+	}
+
+	body, err := render.Render(format, recipe)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, recipes.ToResponse(total))
+	ctx.Data(http.StatusOK, format.ContentType(), body)
+}
+
+func convertAllUnits(recipes model.FoodRecipes, system units.System) model.FoodRecipes {
+	for index, recipe := range recipes {
+		recipes[index] = ConvertUnits(recipe, system)
+	}
+	return recipes
 }
@@ -1,19 +1,25 @@
 package foodrecipe_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -118,7 +124,11 @@ func (suite *HandlerCreateTestSuite) TestResponseRecipeWithStatusCode201() {
 
 	// Expect body
 	expectedBody := dto.FoodRecipeResponse{
-		Name: "Name",
+		Name:        "Name",
+		Ingredients: []dto.RecipeIngredientResponse{},
+		Steps:       []dto.RecipeStepResponse{},
+		Tags:        []dto.TagResponse{},
+		Dietary:     dto.DietaryResponse{Allergens: []string{}},
 	}
 	expectedJson, _ := json.Marshal(expectedBody)
 
@@ -242,7 +252,7 @@ func (suite *HandlerGetByIDTestSuite) SetupTest() {
 
 	suite.errServiceGetByID = nil
 
-	suite.service.On("GetByID", mock.AnythingOfType("string")).Return(func(id string) (model.FoodRecipe, error) {
+	suite.service.On("GetByID", mock.AnythingOfType("string"), mock.Anything).Return(func(id string, claims model.Claims) (model.FoodRecipe, error) {
 		if id == "1" {
 			return suite.respRecipeInServiceGetByID, suite.errServiceGetByID
 		}
@@ -263,6 +273,10 @@ func (suite *HandlerGetByIDTestSuite) TestResponseRecipeWithStatusCode200() {
 		Name:        "Name",
 		Description: "Description",
 		Ingredient:  "Ingredient",
+		Ingredients: []dto.RecipeIngredientResponse{},
+		Steps:       []dto.RecipeStepResponse{},
+		Tags:        []dto.TagResponse{},
+		Dietary:     dto.DietaryResponse{Allergens: []string{}},
 		Instruction: "Instruction",
 		CookingDuration: dto.CookingDurationResponse{
 			ID:   1,
@@ -291,9 +305,230 @@ func (suite *HandlerGetByIDTestSuite) TestErrorWhenRecipeNotFound() {
 	// suite.Equal(http.StatusInternalServerError, response.Code) // passes if response.Code == 500
 	suite.Equal(http.StatusNotFound, response.Code) // passes if response.Code == 404
 	suite.Equal(`{"message":"Recipe not found"}`, response.Body.String())
-	suite.service.AssertCalled(suite.T(), "GetByID", "1")
+	suite.service.AssertCalled(suite.T(), "GetByID", "1", model.Claims{})
+}
+
+// request calls the handler with a query and Accept header of its own.
+func (suite *HandlerGetByIDTestSuite) request(query string, accept string) *httptest.ResponseRecorder {
+	router := gin.Default()
+	router.GET("/api/v1/food-recipes/:id", suite.handler.GetByID)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/food-recipes/1"+query, nil)
+	suite.NoError(err)
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (suite *HandlerGetByIDTestSuite) TestRenderFormatFromQuery() {
+	response := suite.request("?format=markdown", "application/json")
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal("text/markdown; charset=utf-8", response.Header().Get("Content-Type"))
+	suite.True(strings.HasPrefix(response.Body.String(), "# Name\n"))
+}
+
+func (suite *HandlerGetByIDTestSuite) TestRenderFormatFromAccept() {
+	response := suite.request("", "application/ld+json")
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal("application/ld+json; charset=utf-8", response.Header().Get("Content-Type"))
+	suite.Equal("Accept", response.Header().Get("Vary"))
+	suite.Contains(response.Body.String(), `"@type": "Recipe"`)
+}
+
+func (suite *HandlerGetByIDTestSuite) TestRespondJSONWhenAnyFormatAccepted() {
+	response := suite.request("", "*/*")
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal("application/json; charset=utf-8", response.Header().Get("Content-Type"))
+}
+
+func (suite *HandlerGetByIDTestSuite) TestErrorWhenFormatUnknown() {
+	response := suite.request("?format=pdf", "")
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "GetByID", mock.Anything, mock.Anything)
 }
 
 func TestHandlerGetByID(t *testing.T) {
 	suite.Run(t, new(HandlerGetByIDTestSuite))
 }
+
+type HandlerGetTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler foodrecipe.IHandler
+	service *MockIService
+
+	// Helper
+	server func(query string) *httptest.ResponseRecorder
+}
+
+func (suite *HandlerGetTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerGetTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = foodrecipe.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(query string) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+		router.GET("/api/v1/food-recipes", suite.handler.Get)
+
+		// Recorder
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(http.MethodGet, "/api/v1/food-recipes?"+query, nil)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.service.On("Get", mock.Anything).Return(model.FoodRecipes{}, int64(3), pagination.Cursors{}, nil).Maybe()
+	suite.service.On("Facets", mock.Anything).Return(model.FoodRecipeFacets{
+		Difficulties: model.FacetCounts{
+			{ID: 1, Name: "Easy", Count: 3},
+		},
+	}, nil)
+}
+
+func (suite *HandlerGetTestSuite) TestPassFiltersToService() {
+	response := suite.server("page=1&limit=10&difficultyId=1&minRating=4&userId=user-id&createdFrom=2026-01-01&createdTo=2026-01-31")
+
+	suite.Equal(http.StatusOK, response.Code)
+
+	query := suite.service.Calls[0].Arguments.Get(0).(model.FoodRecipeQuery)
+	suite.Equal(uint(1), query.DifficultyID)
+	suite.Equal(4.0, query.MinRating)
+	suite.Equal("user-id", query.UserID)
+	suite.True(query.CreatedFrom.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	suite.True(query.CreatedTo.Equal(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)))
+	suite.service.AssertCalled(suite.T(), "Facets", query)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseFilteredTotalAndFacets() {
+	response := suite.server("page=1&limit=10&difficultyId=1")
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.JSONEq(`{
+		"total": 3,
+		"results": [],
+		"facets": {
+			"difficulties": [{"id": 1, "name": "Easy", "count": 3}],
+			"cookingDurations": []
+		}
+	}`, response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenMinRatingOutOfRange() {
+	response := suite.server("page=1&limit=10&minRating=6")
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenSortUnknown() {
+	response := suite.server("page=1&limit=10&sort=random")
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestPageAndLimitAreOptional() {
+	response := suite.server("")
+
+	suite.Equal(http.StatusOK, response.Code)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseCursors() {
+	suite.service.ExpectedCalls = nil
+	suite.service.On("Get", mock.Anything).Return(model.FoodRecipes{}, int64(3), pagination.Cursors{Next: "next", Prev: "prev"}, nil)
+	suite.service.On("Facets", mock.Anything).Return(model.FoodRecipeFacets{}, nil)
+
+	response := suite.server("cursor=current&limit=10")
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.JSONEq(`{
+		"total": 3,
+		"results": [],
+		"nextCursor": "next",
+		"prevCursor": "prev",
+		"facets": {"difficulties": [], "cookingDurations": []}
+	}`, response.Body.String())
+
+	query := suite.service.Calls[0].Arguments.Get(0).(model.FoodRecipeQuery)
+	suite.Equal("current", query.Cursor)
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenCursorInvalid() {
+	suite.service.ExpectedCalls = nil
+	suite.service.On("Get", mock.Anything).Return(nil, int64(0), pagination.Cursors{}, errors.Wrap(global.ErrorInvalidCursor, "bad"))
+
+	response := suite.server("cursor=bad")
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
+
+type HandlerImportTestSuite struct {
+	suite.Suite
+
+	handler foodrecipe.IHandler
+	service *MockIService
+}
+
+func (suite *HandlerImportTestSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerImportTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = foodrecipe.Handler{
+		Service: suite.service,
+	}
+}
+
+func (suite *HandlerImportTestSuite) TestErrorWhenBodyTooLarge() {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	file, err := form.CreateFormFile("file", "recipe.html")
+	suite.NoError(err)
+	_, err = file.Write(bytes.Repeat([]byte("a"), 2*foodrecipe.MaxImportSize))
+	suite.NoError(err)
+	suite.NoError(form.Close())
+
+	router := gin.Default()
+	router.POST("/api/v1/food-recipes/import", suite.handler.Import)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/food-recipes/import", &body)
+	suite.NoError(err)
+	request.Header.Set("Content-Type", form.FormDataContentType())
+
+	router.ServeHTTP(recorder, request)
+
+	suite.Equal(http.StatusRequestEntityTooLarge, recorder.Code)
+	suite.service.AssertNotCalled(suite.T(), "Import", mock.Anything, mock.Anything)
+}
+
+func TestHandlerImport(t *testing.T) {
+	suite.Run(t, new(HandlerImportTestSuite))
+}
@@ -0,0 +1,194 @@
+package foodrecipe
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/schemaorg"
+	"github.com/klins/devpool/go-day6/wongnok/internal/units"
+)
+
+// MaxImportSize is the largest document POST /food-recipes/import reads. Recipe
+// pages are rarely over a few hundred kilobytes.
+const MaxImportSize = 2 << 20
+
+// ImportRequest turns a schema.org recipe into the request creating it as a
+// draft. Pages say nothing about difficulty, so the draft takes the first
+// one and the author corrects it before publishing.
+func ImportRequest(source schemaorg.Recipe, durations []model.CookingDuration, difficulties []model.Difficulty) dto.FoodRecipeRequest {
+	request := dto.FoodRecipeRequest{
+		Name:              truncate(source.Name, 255),
+		Description:       source.Description,
+		Ingredients:       make([]dto.RecipeIngredientRequest, 0, len(source.Ingredients)),
+		Steps:             make([]dto.RecipeStepRequest, 0, len(source.Instructions)),
+		Servings:          min(source.Yield, 100),
+		Status:            model.RecipeStatusDraft,
+		CookingDurationID: NearestCookingDuration(durations, source.TotalTime),
+	}
+
+	if len(difficulties) > 0 {
+		request.DifficultyID = difficulties[0].ID
+	}
+
+	for _, line := range source.Ingredients {
+		request.Ingredients = append(request.Ingredients, ParseIngredient(line))
+	}
+
+	for index, text := range source.Instructions {
+		request.Steps = append(request.Steps, dto.RecipeStepRequest{Position: index + 1, Text: text})
+	}
+
+	// Relative image paths point at the other site and cannot be shown here
+	if link, err := url.Parse(source.ImageURL); err == nil && (link.Scheme == "http" || link.Scheme == "https") && link.Host != "" {
+		imageURL := link.String()
+		request.ImageURL = &imageURL
+	}
+
+	return request
+}
+
+func truncate(text string, length int) string {
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:length]))
+}
+
+var durationName = regexp.MustCompile(`^(\d+)\s*(?:-\s*(\d+)|(\+))$`)
+
+// NearestCookingDuration returns the cooking duration bucket whose range, read
+// from its name such as "11 - 30" or "60+", is closest to the duration. A
+// duration that is not known falls into the shortest bucket.
+func NearestCookingDuration(durations []model.CookingDuration, duration time.Duration) uint {
+	minutes := math.Round(duration.Minutes())
+
+	var nearest uint
+	distance := math.Inf(1)
+	for _, bucket := range durations {
+		match := durationName.FindStringSubmatch(strings.TrimSpace(bucket.Name))
+		if match == nil {
+			continue
+		}
+
+		from, _ := strconv.ParseFloat(match[1], 64)
+		to := math.Inf(1)
+		if match[3] == "" {
+			to, _ = strconv.ParseFloat(match[2], 64)
+		}
+
+		gap := math.Max(0, math.Max(from-minutes, minutes-to))
+		if gap < distance {
+			nearest, distance = bucket.ID, gap
+		}
+	}
+
+	if nearest == 0 && len(durations) > 0 {
+		return durations[0].ID
+	}
+	return nearest
+}
+
+var (
+	// leadingQuantity matches "2", "1.5", "1/2", "1 1/2", "½" or "1½" at the
+	// start of an ingredient line, as English recipes write them
+	leadingQuantity = regexp.MustCompile(`^(\d+(?:\.\d+)?(?:\s+\d+/\d+)?|\d+/\d+)?\s*([½¼¾⅓⅔⅛])?\s+`)
+	// trailingQuantity matches "กุ้ง 500 กรัม", the name first as Thai recipes
+	// write them
+	trailingQuantity = regexp.MustCompile(`^(.+?)\s+(\d+(?:\.\d+)?|\d+/\d+)\s*(\S.*)?$`)
+	// parenthesised is a note written after the ingredient
+	parenthesised = regexp.MustCompile(`^(.*?)\s*\(([^()]*)\)$`)
+)
+
+var fractions = map[string]float64{"½": 0.5, "¼": 0.25, "¾": 0.75, "⅓": 1.0 / 3, "⅔": 2.0 / 3, "⅛": 0.125}
+
+// ParseIngredient reads an ingredient written as a line of text, such as
+// "2 tbsp fish sauce (optional)", "500 g chicken thighs, sliced" or
+// "น้ำปลา 3 ช้อนโต๊ะ". A leading word is only taken as the unit when it is a
+// unit the converter knows; anything that cannot be read stays in the name.
+func ParseIngredient(line string) dto.RecipeIngredientRequest {
+	line = strings.Join(strings.Fields(line), " ")
+
+	var ingredient dto.RecipeIngredientRequest
+	if match := parenthesised.FindStringSubmatch(line); match != nil && match[1] != "" {
+		line, ingredient.Note = match[1], strings.TrimSpace(match[2])
+	}
+
+	if match := leadingQuantity.FindStringSubmatch(line); match != nil && (match[1] != "" || match[2] != "") {
+		quantity := parseQuantity(match[1]) + fractions[match[2]]
+		rest := line[len(match[0]):]
+
+		ingredient.Quantity = &quantity
+		ingredient.Unit, rest = leadingUnit(rest)
+		ingredient.Name, ingredient.Note = splitNote(rest, ingredient.Note)
+	} else if match := trailingQuantity.FindStringSubmatch(line); match != nil {
+		quantity := parseQuantity(match[2])
+
+		ingredient.Quantity = &quantity
+		ingredient.Unit = truncate(match[3], 50)
+		ingredient.Name, ingredient.Note = splitNote(match[1], ingredient.Note)
+	} else {
+		ingredient.Name, ingredient.Note = splitNote(line, ingredient.Note)
+	}
+
+	if ingredient.Quantity != nil && *ingredient.Quantity <= 0 {
+		ingredient.Quantity = nil
+	}
+	ingredient.Name = truncate(ingredient.Name, 255)
+
+	return ingredient
+}
+
+// parseQuantity reads "2", "1.5", "1/2" or "1 1/2".
+func parseQuantity(text string) float64 {
+	var total float64
+	for _, part := range strings.Fields(text) {
+		if numerator, denominator, ok := strings.Cut(part, "/"); ok {
+			top, _ := strconv.ParseFloat(numerator, 64)
+			bottom, _ := strconv.ParseFloat(denominator, 64)
+			if bottom != 0 {
+				total += top / bottom
+			}
+			continue
+		}
+
+		value, _ := strconv.ParseFloat(part, 64)
+		total += value
+	}
+	return total
+}
+
+// leadingUnit takes the unit off the start of text, trying two words first
+// for units such as "fl oz".
+func leadingUnit(text string) (string, string) {
+	words := strings.SplitN(text, " ", 3)
+
+	for count := min(2, len(words)-1); count >= 1; count-- {
+		unit := strings.Join(words[:count], " ")
+		if _, ok := units.Lookup(strings.TrimSuffix(unit, ".")); ok {
+			return unit, strings.Join(words[count:], " ")
+		}
+	}
+	return "", text
+}
+
+// splitNote keeps what follows the first comma as the note, as in "chicken
+// thighs, sliced", unless a note was already written in brackets.
+func splitNote(text string, note string) (string, string) {
+	name, rest, ok := strings.Cut(text, ",")
+	if !ok {
+		return strings.TrimSpace(text), note
+	}
+
+	rest = strings.TrimSpace(rest)
+	if note != "" {
+		rest = strings.TrimSpace(rest + " " + note)
+	}
+	return strings.TrimSpace(name), rest
+}
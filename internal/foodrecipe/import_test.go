@@ -0,0 +1,142 @@
+package foodrecipe_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/schemaorg"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func quantity(value float64) *float64 {
+	return &value
+}
+
+func TestParseIngredient(t *testing.T) {
+	cases := map[string]struct {
+		line     string
+		expected dto.RecipeIngredientRequest
+	}{
+		"ShouldReadQuantityAndUnit": {
+			line:     "2 tbsp fish sauce",
+			expected: dto.RecipeIngredientRequest{Name: "fish sauce", Quantity: quantity(2), Unit: "tbsp"},
+		},
+		"ShouldReadMixedFraction": {
+			line:     "1 1/2 cups coconut milk",
+			expected: dto.RecipeIngredientRequest{Name: "coconut milk", Quantity: quantity(1.5), Unit: "cups"},
+		},
+		"ShouldReadUnicodeFraction": {
+			line:     "½ tsp salt",
+			expected: dto.RecipeIngredientRequest{Name: "salt", Quantity: quantity(0.5), Unit: "tsp"},
+		},
+		"ShouldReadTwoWordUnit": {
+			line:     "4 fl oz lime juice",
+			expected: dto.RecipeIngredientRequest{Name: "lime juice", Quantity: quantity(4), Unit: "fl oz"},
+		},
+		"ShouldKeepUnknownUnitInName": {
+			line:     "3 cloves garlic",
+			expected: dto.RecipeIngredientRequest{Name: "cloves garlic", Quantity: quantity(3)},
+		},
+		"ShouldKeepTextAfterCommaAsNote": {
+			line:     "500 g chicken thighs, sliced",
+			expected: dto.RecipeIngredientRequest{Name: "chicken thighs", Quantity: quantity(500), Unit: "g", Note: "sliced"},
+		},
+		"ShouldKeepBracketsAsNote": {
+			line:     "1 tbsp sugar (optional)",
+			expected: dto.RecipeIngredientRequest{Name: "sugar", Quantity: quantity(1), Unit: "tbsp", Note: "optional"},
+		},
+		"ShouldReadThaiOrder": {
+			line:     "น้ำปลา 3 ช้อนโต๊ะ",
+			expected: dto.RecipeIngredientRequest{Name: "น้ำปลา", Quantity: quantity(3), Unit: "ช้อนโต๊ะ"},
+		},
+		"ShouldKeepLineWithoutQuantity": {
+			line:     "Salt,  to taste",
+			expected: dto.RecipeIngredientRequest{Name: "Salt", Note: "to taste"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, foodrecipe.ParseIngredient(tc.line))
+		})
+	}
+}
+
+func TestNearestCookingDuration(t *testing.T) {
+	durations := []model.CookingDuration{
+		{Model: gorm.Model{ID: 1}, Name: "5 - 10"},
+		{Model: gorm.Model{ID: 2}, Name: "11 - 30"},
+		{Model: gorm.Model{ID: 3}, Name: "31 - 60"},
+		{Model: gorm.Model{ID: 4}, Name: "60+"},
+	}
+
+	cases := map[string]struct {
+		duration time.Duration
+		expected uint
+	}{
+		"ShouldPickBucketHoldingDuration": {duration: 25 * time.Minute, expected: 2},
+		"ShouldPickBucketOnItsBound":      {duration: 60 * time.Minute, expected: 3},
+		"ShouldRoundToMinutes":            {duration: 10*time.Minute + 20*time.Second, expected: 1},
+		"ShouldPickOpenBucket":            {duration: 3 * time.Hour, expected: 4},
+		"ShouldPickNearestBelowShortest":  {duration: 2 * time.Minute, expected: 1},
+		"ShouldPickShortestWhenUnknown":   {duration: 0, expected: 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, foodrecipe.NearestCookingDuration(durations, tc.duration))
+		})
+	}
+}
+
+func TestImportRequest(t *testing.T) {
+	durations := []model.CookingDuration{{Model: gorm.Model{ID: 1}, Name: "5 - 10"}, {Model: gorm.Model{ID: 2}, Name: "11 - 30"}}
+	difficulties := []model.Difficulty{{Model: gorm.Model{ID: 1}, Name: "Easy"}, {Model: gorm.Model{ID: 2}, Name: "Medium"}}
+
+	t.Run("ShouldMapRecipeIntoDraft", func(t *testing.T) {
+		request := foodrecipe.ImportRequest(schemaorg.Recipe{
+			Name:         "Pad Kra Pao",
+			Description:  "Stir-fried pork with holy basil.",
+			Ingredients:  []string{"300 g minced pork", "2 eggs"},
+			Instructions: []string{"Fry the garlic.", "Add the pork."},
+			TotalTime:    25 * time.Minute,
+			Yield:        2,
+			ImageURL:     "https://example.com/pad-kra-pao.jpg",
+		}, durations, difficulties)
+
+		imageURL := "https://example.com/pad-kra-pao.jpg"
+		assert.Equal(t, dto.FoodRecipeRequest{
+			Name:        "Pad Kra Pao",
+			Description: "Stir-fried pork with holy basil.",
+			Ingredients: []dto.RecipeIngredientRequest{
+				{Name: "minced pork", Quantity: quantity(300), Unit: "g"},
+				{Name: "eggs", Quantity: quantity(2)},
+			},
+			Steps: []dto.RecipeStepRequest{
+				{Position: 1, Text: "Fry the garlic."},
+				{Position: 2, Text: "Add the pork."},
+			},
+			ImageURL:          &imageURL,
+			Servings:          2,
+			Status:            model.RecipeStatusDraft,
+			CookingDurationID: 2,
+			DifficultyID:      1,
+		}, request)
+	})
+
+	t.Run("ShouldDropRelativeImage", func(t *testing.T) {
+		request := foodrecipe.ImportRequest(schemaorg.Recipe{Name: "Name", ImageURL: "/images/name.jpg"}, durations, difficulties)
+
+		assert.Nil(t, request.ImageURL)
+	})
+
+	t.Run("ShouldCapServings", func(t *testing.T) {
+		request := foodrecipe.ImportRequest(schemaorg.Recipe{Name: "Cookies", Yield: 240}, durations, difficulties)
+
+		assert.Equal(t, 100, request.Servings)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -118,6 +119,86 @@ func (_c *MockIHandler_Delete_Call) RunAndReturn(run func(ctx *gin.Context)) *Mo
 	return _c
 }
 
+// DiffRevisions provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) DiffRevisions(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_DiffRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRevisions'
+type MockIHandler_DiffRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffRevisions is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) DiffRevisions(ctx interface{}) *MockIHandler_DiffRevisions_Call {
+	return &MockIHandler_DiffRevisions_Call{Call: _e.mock.On("DiffRevisions", ctx)}
+}
+
+func (_c *MockIHandler_DiffRevisions_Call) Run(run func(ctx *gin.Context)) *MockIHandler_DiffRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_DiffRevisions_Call) Return() *MockIHandler_DiffRevisions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_DiffRevisions_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_DiffRevisions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Fork provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Fork(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Fork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fork'
+type MockIHandler_Fork_Call struct {
+	*mock.Call
+}
+
+// Fork is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Fork(ctx interface{}) *MockIHandler_Fork_Call {
+	return &MockIHandler_Fork_Call{Call: _e.mock.On("Fork", ctx)}
+}
+
+func (_c *MockIHandler_Fork_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Fork_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Fork_Call) Return() *MockIHandler_Fork_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Fork_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Fork_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockIHandler
 func (_mock *MockIHandler) Get(ctx *gin.Context) {
 	_mock.Called(ctx)
@@ -198,24 +279,24 @@ func (_c *MockIHandler_GetByID_Call) RunAndReturn(run func(ctx *gin.Context)) *M
 	return _c
 }
 
-// Update provides a mock function for the type MockIHandler
-func (_mock *MockIHandler) Update(ctx *gin.Context) {
+// GetFavorites provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetFavorites(ctx *gin.Context) {
 	_mock.Called(ctx)
 	return
 }
 
-// MockIHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockIHandler_Update_Call struct {
+// MockIHandler_GetFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavorites'
+type MockIHandler_GetFavorites_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// GetFavorites is a helper method to define mock.On call
 //   - ctx *gin.Context
-func (_e *MockIHandler_Expecter) Update(ctx interface{}) *MockIHandler_Update_Call {
-	return &MockIHandler_Update_Call{Call: _e.mock.On("Update", ctx)}
+func (_e *MockIHandler_Expecter) GetFavorites(ctx interface{}) *MockIHandler_GetFavorites_Call {
+	return &MockIHandler_GetFavorites_Call{Call: _e.mock.On("GetFavorites", ctx)}
 }
 
-func (_c *MockIHandler_Update_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+func (_c *MockIHandler_GetFavorites_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetFavorites_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *gin.Context
 		if args[0] != nil {
@@ -228,129 +309,78 @@ func (_c *MockIHandler_Update_Call) Run(run func(ctx *gin.Context)) *MockIHandle
 	return _c
 }
 
-func (_c *MockIHandler_Update_Call) Return() *MockIHandler_Update_Call {
+func (_c *MockIHandler_GetFavorites_Call) Return() *MockIHandler_GetFavorites_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockIHandler_Update_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+func (_c *MockIHandler_GetFavorites_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetFavorites_Call {
 	_c.Run(run)
 	return _c
 }
 
-// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIRepository {
-	mock := &MockIRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockIRepository is an autogenerated mock type for the IRepository type
-type MockIRepository struct {
-	mock.Mock
-}
-
-type MockIRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
-	return &MockIRepository_Expecter{mock: &_m.Mock}
-}
-
-// Count provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Count() (int64, error) {
-	ret := _mock.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for Count")
-	}
-
-	var r0 int64
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
-		return returnFunc()
-	}
-	if returnFunc, ok := ret.Get(0).(func() int64); ok {
-		r0 = returnFunc()
-	} else {
-		r0 = ret.Get(0).(int64)
-	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
+// GetForks provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetForks(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
 }
 
-// MockIRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
-type MockIRepository_Count_Call struct {
+// MockIHandler_GetForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForks'
+type MockIHandler_GetForks_Call struct {
 	*mock.Call
 }
 
-// Count is a helper method to define mock.On call
-func (_e *MockIRepository_Expecter) Count() *MockIRepository_Count_Call {
-	return &MockIRepository_Count_Call{Call: _e.mock.On("Count")}
+// GetForks is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetForks(ctx interface{}) *MockIHandler_GetForks_Call {
+	return &MockIHandler_GetForks_Call{Call: _e.mock.On("GetForks", ctx)}
 }
 
-func (_c *MockIRepository_Count_Call) Run(run func()) *MockIRepository_Count_Call {
+func (_c *MockIHandler_GetForks_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetForks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_Count_Call) Return(n int64, err error) *MockIRepository_Count_Call {
-	_c.Call.Return(n, err)
+func (_c *MockIHandler_GetForks_Call) Return() *MockIHandler_GetForks_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockIRepository_Count_Call) RunAndReturn(run func() (int64, error)) *MockIRepository_Count_Call {
-	_c.Call.Return(run)
+func (_c *MockIHandler_GetForks_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetForks_Call {
+	_c.Run(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Create(recipe *model.FoodRecipe) error {
-	ret := _mock.Called(recipe)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Create")
-	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
-		r0 = returnFunc(recipe)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// GetRevision provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetRevision(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
 }
 
-// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIRepository_Create_Call struct {
+// MockIHandler_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockIHandler_GetRevision_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - recipe *model.FoodRecipe
-func (_e *MockIRepository_Expecter) Create(recipe interface{}) *MockIRepository_Create_Call {
-	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", recipe)}
+// GetRevision is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetRevision(ctx interface{}) *MockIHandler_GetRevision_Call {
+	return &MockIHandler_GetRevision_Call{Call: _e.mock.On("GetRevision", ctx)}
 }
 
-func (_c *MockIRepository_Create_Call) Run(run func(recipe *model.FoodRecipe)) *MockIRepository_Create_Call {
+func (_c *MockIHandler_GetRevision_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetRevision_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.FoodRecipe
+		var arg0 *gin.Context
 		if args[0] != nil {
-			arg0 = args[0].(*model.FoodRecipe)
+			arg0 = args[0].(*gin.Context)
 		}
 		run(
 			arg0,
@@ -359,49 +389,38 @@ func (_c *MockIRepository_Create_Call) Run(run func(recipe *model.FoodRecipe)) *
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockIHandler_GetRevision_Call) Return() *MockIHandler_GetRevision_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockIRepository_Create_Call {
-	_c.Call.Return(run)
+func (_c *MockIHandler_GetRevision_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetRevision_Call {
+	_c.Run(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Delete(id string) error {
-	ret := _mock.Called(id)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Delete")
-	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(id)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
+// GetRevisions provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetRevisions(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
 }
 
-// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockIRepository_Delete_Call struct {
+// MockIHandler_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type MockIHandler_GetRevisions_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
-//   - id string
-func (_e *MockIRepository_Expecter) Delete(id interface{}) *MockIRepository_Delete_Call {
-	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+// GetRevisions is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetRevisions(ctx interface{}) *MockIHandler_GetRevisions_Call {
+	return &MockIHandler_GetRevisions_Call{Call: _e.mock.On("GetRevisions", ctx)}
 }
 
-func (_c *MockIRepository_Delete_Call) Run(run func(id string)) *MockIRepository_Delete_Call {
+func (_c *MockIHandler_GetRevisions_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetRevisions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *gin.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(*gin.Context)
 		}
 		run(
 			arg0,
@@ -410,60 +429,38 @@ func (_c *MockIRepository_Delete_Call) Run(run func(id string)) *MockIRepository
 	return _c
 }
 
-func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockIHandler_GetRevisions_Call) Return() *MockIHandler_GetRevisions_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id string) error) *MockIRepository_Delete_Call {
-	_c.Call.Return(run)
+func (_c *MockIHandler_GetRevisions_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetRevisions_Call {
+	_c.Run(run)
 	return _c
 }
 
-// Get provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Get(query model.FoodRecipeQuery) (model.FoodRecipes, error) {
-	ret := _mock.Called(query)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Get")
-	}
-
-	var r0 model.FoodRecipes
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, error)); ok {
-		return returnFunc(query)
-	}
-	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
-		r0 = returnFunc(query)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.FoodRecipes)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
-		r1 = returnFunc(query)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
+// GetScaled provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetScaled(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
 }
 
-// MockIRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockIRepository_Get_Call struct {
+// MockIHandler_GetScaled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScaled'
+type MockIHandler_GetScaled_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - query model.FoodRecipeQuery
-func (_e *MockIRepository_Expecter) Get(query interface{}) *MockIRepository_Get_Call {
-	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", query)}
+// GetScaled is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetScaled(ctx interface{}) *MockIHandler_GetScaled_Call {
+	return &MockIHandler_GetScaled_Call{Call: _e.mock.On("GetScaled", ctx)}
 }
 
-func (_c *MockIRepository_Get_Call) Run(run func(query model.FoodRecipeQuery)) *MockIRepository_Get_Call {
+func (_c *MockIHandler_GetScaled_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetScaled_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.FoodRecipeQuery
+		var arg0 *gin.Context
 		if args[0] != nil {
-			arg0 = args[0].(model.FoodRecipeQuery)
+			arg0 = args[0].(*gin.Context)
 		}
 		run(
 			arg0,
@@ -472,21 +469,1949 @@ func (_c *MockIRepository_Get_Call) Run(run func(query model.FoodRecipeQuery)) *
 	return _c
 }
 
-func (_c *MockIRepository_Get_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_Get_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIHandler_GetScaled_Call) Return() *MockIHandler_GetScaled_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockIRepository_Get_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (model.FoodRecipes, error)) *MockIRepository_Get_Call {
-	_c.Call.Return(run)
+func (_c *MockIHandler_GetScaled_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetScaled_Call {
+	_c.Run(run)
 	return _c
 }
 
-// GetAll provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) GetAll() ([]model.FoodRecipe, error) {
-	ret := _mock.Called()
-
-	if len(ret) == 0 {
+// Import provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Import(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockIHandler_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Import(ctx interface{}) *MockIHandler_Import_Call {
+	return &MockIHandler_Import_Call{Call: _e.mock.On("Import", ctx)}
+}
+
+func (_c *MockIHandler_Import_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Import_Call) Return() *MockIHandler_Import_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Import_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Import_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Publish(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockIHandler_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Publish(ctx interface{}) *MockIHandler_Publish_Call {
+	return &MockIHandler_Publish_Call{Call: _e.mock.On("Publish", ctx)}
+}
+
+func (_c *MockIHandler_Publish_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Publish_Call) Return() *MockIHandler_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Publish_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Revert provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Revert(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type MockIHandler_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Revert(ctx interface{}) *MockIHandler_Revert_Call {
+	return &MockIHandler_Revert_Call{Call: _e.mock.On("Revert", ctx)}
+}
+
+func (_c *MockIHandler_Revert_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Revert_Call) Return() *MockIHandler_Revert_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Revert_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Revert_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Update(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Update(ctx interface{}) *MockIHandler_Update_Call {
+	return &MockIHandler_Update_Call{Call: _e.mock.On("Update", ctx)}
+}
+
+func (_c *MockIHandler_Update_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) Return() *MockIHandler_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRepository {
+	mock := &MockIRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIRepository is an autogenerated mock type for the IRepository type
+type MockIRepository struct {
+	mock.Mock
+}
+
+type MockIRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
+	return &MockIRepository_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Count(query model.FoodRecipeQuery) (int64, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (int64, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) int64); ok {
+		r0 = returnFunc(query)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockIRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+func (_e *MockIRepository_Expecter) Count(query interface{}) *MockIRepository_Count_Call {
+	return &MockIRepository_Count_Call{Call: _e.mock.On("Count", query)}
+}
+
+func (_c *MockIRepository_Count_Call) Run(run func(query model.FoodRecipeQuery)) *MockIRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Count_Call) Return(n int64, err error) *MockIRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_Count_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (int64, error)) *MockIRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFavorites provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) CountFavorites(query model.FoodRecipeQuery, userID string) (int64, error) {
+	ret := _mock.Called(query, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFavorites")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) (int64, error)); ok {
+		return returnFunc(query, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) int64); ok {
+		r0 = returnFunc(query, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, string) error); ok {
+		r1 = returnFunc(query, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_CountFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFavorites'
+type MockIRepository_CountFavorites_Call struct {
+	*mock.Call
+}
+
+// CountFavorites is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+//   - userID string
+func (_e *MockIRepository_Expecter) CountFavorites(query interface{}, userID interface{}) *MockIRepository_CountFavorites_Call {
+	return &MockIRepository_CountFavorites_Call{Call: _e.mock.On("CountFavorites", query, userID)}
+}
+
+func (_c *MockIRepository_CountFavorites_Call) Run(run func(query model.FoodRecipeQuery, userID string)) *MockIRepository_CountFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_CountFavorites_Call) Return(n int64, err error) *MockIRepository_CountFavorites_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_CountFavorites_Call) RunAndReturn(run func(query model.FoodRecipeQuery, userID string) (int64, error)) *MockIRepository_CountFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountForks provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) CountForks(recipeID uint) (int64, error) {
+	ret := _mock.Called(recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountForks")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return returnFunc(recipeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = returnFunc(recipeID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_CountForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountForks'
+type MockIRepository_CountForks_Call struct {
+	*mock.Call
+}
+
+// CountForks is a helper method to define mock.On call
+//   - recipeID uint
+func (_e *MockIRepository_Expecter) CountForks(recipeID interface{}) *MockIRepository_CountForks_Call {
+	return &MockIRepository_CountForks_Call{Call: _e.mock.On("CountForks", recipeID)}
+}
+
+func (_c *MockIRepository_CountForks_Call) Run(run func(recipeID uint)) *MockIRepository_CountForks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_CountForks_Call) Return(n int64, err error) *MockIRepository_CountForks_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_CountForks_Call) RunAndReturn(run func(recipeID uint) (int64, error)) *MockIRepository_CountForks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountTags provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) CountTags(ids []uint) (int64, error) {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTags")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint) (int64, error)); ok {
+		return returnFunc(ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint) int64); ok {
+		r0 = returnFunc(ids)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = returnFunc(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_CountTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTags'
+type MockIRepository_CountTags_Call struct {
+	*mock.Call
+}
+
+// CountTags is a helper method to define mock.On call
+//   - ids []uint
+func (_e *MockIRepository_Expecter) CountTags(ids interface{}) *MockIRepository_CountTags_Call {
+	return &MockIRepository_CountTags_Call{Call: _e.mock.On("CountTags", ids)}
+}
+
+func (_c *MockIRepository_CountTags_Call) Run(run func(ids []uint)) *MockIRepository_CountTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_CountTags_Call) Return(n int64, err error) *MockIRepository_CountTags_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_CountTags_Call) RunAndReturn(run func(ids []uint) (int64, error)) *MockIRepository_CountTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Create(recipe *model.FoodRecipe) error {
+	ret := _mock.Called(recipe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
+		r0 = returnFunc(recipe)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+func (_e *MockIRepository_Expecter) Create(recipe interface{}) *MockIRepository_Create_Call {
+	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", recipe)}
+}
+
+func (_c *MockIRepository_Create_Call) Run(run func(recipe *model.FoodRecipe)) *MockIRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockIRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Delete(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id string
+func (_e *MockIRepository_Expecter) Delete(id interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockIRepository_Delete_Call) Run(run func(id string)) *MockIRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id string) error) *MockIRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Facets provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Facets(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Facets")
+	}
+
+	var r0 model.FoodRecipeFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipeFacets, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipeFacets); ok {
+		r0 = returnFunc(query)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipeFacets)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Facets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Facets'
+type MockIRepository_Facets_Call struct {
+	*mock.Call
+}
+
+// Facets is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+func (_e *MockIRepository_Expecter) Facets(query interface{}) *MockIRepository_Facets_Call {
+	return &MockIRepository_Facets_Call{Call: _e.mock.On("Facets", query)}
+}
+
+func (_c *MockIRepository_Facets_Call) Run(run func(query model.FoodRecipeQuery)) *MockIRepository_Facets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Facets_Call) Return(foodRecipeFacets model.FoodRecipeFacets, err error) *MockIRepository_Facets_Call {
+	_c.Call.Return(foodRecipeFacets, err)
+	return _c
+}
+
+func (_c *MockIRepository_Facets_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error)) *MockIRepository_Facets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Get(query model.FoodRecipeQuery) (model.FoodRecipes, pagination.Cursors, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, pagination.Cursors, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) pagination.Cursors); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Get(1).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery) error); ok {
+		r2 = returnFunc(query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+func (_e *MockIRepository_Expecter) Get(query interface{}) *MockIRepository_Get_Call {
+	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", query)}
+}
+
+func (_c *MockIRepository_Get_Call) Run(run func(query model.FoodRecipeQuery)) *MockIRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) Return(foodRecipes model.FoodRecipes, cursors pagination.Cursors, err error) *MockIRepository_Get_Call {
+	_c.Call.Return(foodRecipes, cursors, err)
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (model.FoodRecipes, pagination.Cursors, error)) *MockIRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetAll() ([]model.FoodRecipe, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]model.FoodRecipe, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []model.FoodRecipe); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.FoodRecipe)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockIRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+func (_e *MockIRepository_Expecter) GetAll() *MockIRepository_GetAll_Call {
+	return &MockIRepository_GetAll_Call{Call: _e.mock.On("GetAll")}
+}
+
+func (_c *MockIRepository_GetAll_Call) Run(run func()) *MockIRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetAll_Call) Return(foodRecipes []model.FoodRecipe, err error) *MockIRepository_GetAll_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetAll_Call) RunAndReturn(run func() ([]model.FoodRecipe, error)) *MockIRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttribution provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetAttribution(recipeID uint) (model.RecipeAttributions, error) {
+	ret := _mock.Called(recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttribution")
+	}
+
+	var r0 model.RecipeAttributions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) (model.RecipeAttributions, error)); ok {
+		return returnFunc(recipeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) model.RecipeAttributions); ok {
+		r0 = returnFunc(recipeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.RecipeAttributions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetAttribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttribution'
+type MockIRepository_GetAttribution_Call struct {
+	*mock.Call
+}
+
+// GetAttribution is a helper method to define mock.On call
+//   - recipeID uint
+func (_e *MockIRepository_Expecter) GetAttribution(recipeID interface{}) *MockIRepository_GetAttribution_Call {
+	return &MockIRepository_GetAttribution_Call{Call: _e.mock.On("GetAttribution", recipeID)}
+}
+
+func (_c *MockIRepository_GetAttribution_Call) Run(run func(recipeID uint)) *MockIRepository_GetAttribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetAttribution_Call) Return(recipeAttributions model.RecipeAttributions, err error) *MockIRepository_GetAttribution_Call {
+	_c.Call.Return(recipeAttributions, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetAttribution_Call) RunAndReturn(run func(recipeID uint) (model.RecipeAttributions, error)) *MockIRepository_GetAttribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByID(id string) (model.FoodRecipe, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipe, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipe); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIRepository_GetByID_Call) Run(run func(id string)) *MockIRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id string) (model.FoodRecipe, error)) *MockIRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByIDs(ids []uint) (model.FoodRecipes, error) {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint) (model.FoodRecipes, error)); ok {
+		return returnFunc(ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint) model.FoodRecipes); ok {
+		r0 = returnFunc(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = returnFunc(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockIRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ids []uint
+func (_e *MockIRepository_Expecter) GetByIDs(ids interface{}) *MockIRepository_GetByIDs_Call {
+	return &MockIRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ids)}
+}
+
+func (_c *MockIRepository_GetByIDs_Call) Run(run func(ids []uint)) *MockIRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByIDs_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetByIDs_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByIDs_Call) RunAndReturn(run func(ids []uint) (model.FoodRecipes, error)) *MockIRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCookingDurations provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetCookingDurations() ([]model.CookingDuration, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCookingDurations")
+	}
+
+	var r0 []model.CookingDuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]model.CookingDuration, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []model.CookingDuration); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CookingDuration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetCookingDurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCookingDurations'
+type MockIRepository_GetCookingDurations_Call struct {
+	*mock.Call
+}
+
+// GetCookingDurations is a helper method to define mock.On call
+func (_e *MockIRepository_Expecter) GetCookingDurations() *MockIRepository_GetCookingDurations_Call {
+	return &MockIRepository_GetCookingDurations_Call{Call: _e.mock.On("GetCookingDurations")}
+}
+
+func (_c *MockIRepository_GetCookingDurations_Call) Run(run func()) *MockIRepository_GetCookingDurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetCookingDurations_Call) Return(cookingDurations []model.CookingDuration, err error) *MockIRepository_GetCookingDurations_Call {
+	_c.Call.Return(cookingDurations, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetCookingDurations_Call) RunAndReturn(run func() ([]model.CookingDuration, error)) *MockIRepository_GetCookingDurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDifficulties provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetDifficulties() ([]model.Difficulty, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDifficulties")
+	}
+
+	var r0 []model.Difficulty
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]model.Difficulty, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []model.Difficulty); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Difficulty)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetDifficulties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDifficulties'
+type MockIRepository_GetDifficulties_Call struct {
+	*mock.Call
+}
+
+// GetDifficulties is a helper method to define mock.On call
+func (_e *MockIRepository_Expecter) GetDifficulties() *MockIRepository_GetDifficulties_Call {
+	return &MockIRepository_GetDifficulties_Call{Call: _e.mock.On("GetDifficulties")}
+}
+
+func (_c *MockIRepository_GetDifficulties_Call) Run(run func()) *MockIRepository_GetDifficulties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetDifficulties_Call) Return(difficultys []model.Difficulty, err error) *MockIRepository_GetDifficulties_Call {
+	_c.Call.Return(difficultys, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetDifficulties_Call) RunAndReturn(run func() ([]model.Difficulty, error)) *MockIRepository_GetDifficulties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavorites provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetFavorites(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, pagination.Cursors, error) {
+	ret := _mock.Called(query, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) (model.FoodRecipes, pagination.Cursors, error)); ok {
+		return returnFunc(query, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) model.FoodRecipes); ok {
+		r0 = returnFunc(query, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, string) pagination.Cursors); ok {
+		r1 = returnFunc(query, userID)
+	} else {
+		r1 = ret.Get(1).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery, string) error); ok {
+		r2 = returnFunc(query, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIRepository_GetFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavorites'
+type MockIRepository_GetFavorites_Call struct {
+	*mock.Call
+}
+
+// GetFavorites is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+//   - userID string
+func (_e *MockIRepository_Expecter) GetFavorites(query interface{}, userID interface{}) *MockIRepository_GetFavorites_Call {
+	return &MockIRepository_GetFavorites_Call{Call: _e.mock.On("GetFavorites", query, userID)}
+}
+
+func (_c *MockIRepository_GetFavorites_Call) Run(run func(query model.FoodRecipeQuery, userID string)) *MockIRepository_GetFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetFavorites_Call) Return(foodRecipes model.FoodRecipes, cursors pagination.Cursors, err error) *MockIRepository_GetFavorites_Call {
+	_c.Call.Return(foodRecipes, cursors, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetFavorites_Call) RunAndReturn(run func(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, pagination.Cursors, error)) *MockIRepository_GetFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForks provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetForks(recipeID uint, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error) {
+	ret := _mock.Called(recipeID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForks")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(uint, model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)); ok {
+		return returnFunc(recipeID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, model.PageQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(recipeID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, model.PageQuery) pagination.Cursors); ok {
+		r1 = returnFunc(recipeID, query)
+	} else {
+		r1 = ret.Get(1).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(2).(func(uint, model.PageQuery) error); ok {
+		r2 = returnFunc(recipeID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIRepository_GetForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForks'
+type MockIRepository_GetForks_Call struct {
+	*mock.Call
+}
+
+// GetForks is a helper method to define mock.On call
+//   - recipeID uint
+//   - query model.PageQuery
+func (_e *MockIRepository_Expecter) GetForks(recipeID interface{}, query interface{}) *MockIRepository_GetForks_Call {
+	return &MockIRepository_GetForks_Call{Call: _e.mock.On("GetForks", recipeID, query)}
+}
+
+func (_c *MockIRepository_GetForks_Call) Run(run func(recipeID uint, query model.PageQuery)) *MockIRepository_GetForks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 model.PageQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PageQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetForks_Call) Return(foodRecipes model.FoodRecipes, cursors pagination.Cursors, err error) *MockIRepository_GetForks_Call {
+	_c.Call.Return(foodRecipes, cursors, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetForks_Call) RunAndReturn(run func(recipeID uint, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)) *MockIRepository_GetForks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevision provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetRevision(recipeID uint, number int) (model.RecipeRevision, error) {
+	ret := _mock.Called(recipeID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 model.RecipeRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, int) (model.RecipeRevision, error)); ok {
+		return returnFunc(recipeID, number)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, int) model.RecipeRevision); ok {
+		r0 = returnFunc(recipeID, number)
+	} else {
+		r0 = ret.Get(0).(model.RecipeRevision)
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = returnFunc(recipeID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockIRepository_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - recipeID uint
+//   - number int
+func (_e *MockIRepository_Expecter) GetRevision(recipeID interface{}, number interface{}) *MockIRepository_GetRevision_Call {
+	return &MockIRepository_GetRevision_Call{Call: _e.mock.On("GetRevision", recipeID, number)}
+}
+
+func (_c *MockIRepository_GetRevision_Call) Run(run func(recipeID uint, number int)) *MockIRepository_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetRevision_Call) Return(recipeRevision model.RecipeRevision, err error) *MockIRepository_GetRevision_Call {
+	_c.Call.Return(recipeRevision, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetRevision_Call) RunAndReturn(run func(recipeID uint, number int) (model.RecipeRevision, error)) *MockIRepository_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisions provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetRevisions(recipeID uint) (model.RecipeRevisions, error) {
+	ret := _mock.Called(recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 model.RecipeRevisions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) (model.RecipeRevisions, error)); ok {
+		return returnFunc(recipeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) model.RecipeRevisions); ok {
+		r0 = returnFunc(recipeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.RecipeRevisions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type MockIRepository_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - recipeID uint
+func (_e *MockIRepository_Expecter) GetRevisions(recipeID interface{}) *MockIRepository_GetRevisions_Call {
+	return &MockIRepository_GetRevisions_Call{Call: _e.mock.On("GetRevisions", recipeID)}
+}
+
+func (_c *MockIRepository_GetRevisions_Call) Run(run func(recipeID uint)) *MockIRepository_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetRevisions_Call) Return(recipeRevisions model.RecipeRevisions, err error) *MockIRepository_GetRevisions_Call {
+	_c.Call.Return(recipeRevisions, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetRevisions_Call) RunAndReturn(run func(recipeID uint) (model.RecipeRevisions, error)) *MockIRepository_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(recipe *model.FoodRecipe, editorID string) error {
+	ret := _mock.Called(recipe, editorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe, string) error); ok {
+		r0 = returnFunc(recipe, editorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+//   - editorID string
+func (_e *MockIRepository_Expecter) Update(recipe interface{}, editorID interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", recipe, editorID)}
+}
+
+func (_c *MockIRepository_Update_Call) Run(run func(recipe *model.FoodRecipe, editorID string)) *MockIRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) RunAndReturn(run func(recipe *model.FoodRecipe, editorID string) error) *MockIRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDerived provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateDerived(recipe *model.FoodRecipe) error {
+	ret := _mock.Called(recipe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDerived")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
+		r0 = returnFunc(recipe)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateDerived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDerived'
+type MockIRepository_UpdateDerived_Call struct {
+	*mock.Call
+}
+
+// UpdateDerived is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+func (_e *MockIRepository_Expecter) UpdateDerived(recipe interface{}) *MockIRepository_UpdateDerived_Call {
+	return &MockIRepository_UpdateDerived_Call{Call: _e.mock.On("UpdateDerived", recipe)}
+}
+
+func (_c *MockIRepository_UpdateDerived_Call) Run(run func(recipe *model.FoodRecipe)) *MockIRepository_UpdateDerived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateDerived_Call) Return(err error) *MockIRepository_UpdateDerived_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateDerived_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockIRepository_UpdateDerived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateStatus(recipe *model.FoodRecipe) error {
+	ret := _mock.Called(recipe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
+		r0 = returnFunc(recipe)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockIRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+func (_e *MockIRepository_Expecter) UpdateStatus(recipe interface{}) *MockIRepository_UpdateStatus_Call {
+	return &MockIRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", recipe)}
+}
+
+func (_c *MockIRepository_UpdateStatus_Call) Run(run func(recipe *model.FoodRecipe)) *MockIRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateStatus_Call) Return(err error) *MockIRepository_UpdateStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateStatus_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockIRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIService {
+	mock := &MockIService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
+	mock.Mock
+}
+
+type MockIService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function for the type MockIService
+func (_mock *MockIService) Count() (int64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockIService_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+func (_e *MockIService_Expecter) Count() *MockIService_Count_Call {
+	return &MockIService_Count_Call{Call: _e.mock.On("Count")}
+}
+
+func (_c *MockIService_Count_Call) Run(run func()) *MockIService_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIService_Count_Call) Return(n int64, err error) *MockIService_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIService_Count_Call) RunAndReturn(run func() (int64, error)) *MockIService_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIService
+func (_mock *MockIService) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Create(request interface{}, claims interface{}) *MockIService_Create_Call {
+	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIService_Create_Call) Run(run func(request dto.FoodRecipeRequest, claims model.Claims)) *MockIService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FoodRecipeRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Create_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Create_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIService
+func (_mock *MockIService) Delete(id string, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Delete(id interface{}, claims interface{}) *MockIService_Delete_Call {
+	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIService_Delete_Call) Run(run func(id string, claims model.Claims)) *MockIService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) RunAndReturn(run func(id string, claims model.Claims) error) *MockIService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffRevisions provides a mock function for the type MockIService
+func (_mock *MockIService) DiffRevisions(id string, from int, to int, claims model.Claims) (model.RevisionDiff, error) {
+	ret := _mock.Called(id, from, to, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRevisions")
+	}
+
+	var r0 model.RevisionDiff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int, int, model.Claims) (model.RevisionDiff, error)); ok {
+		return returnFunc(id, from, to, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int, int, model.Claims) model.RevisionDiff); ok {
+		r0 = returnFunc(id, from, to, claims)
+	} else {
+		r0 = ret.Get(0).(model.RevisionDiff)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int, int, model.Claims) error); ok {
+		r1 = returnFunc(id, from, to, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_DiffRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRevisions'
+type MockIService_DiffRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffRevisions is a helper method to define mock.On call
+//   - id string
+//   - from int
+//   - to int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) DiffRevisions(id interface{}, from interface{}, to interface{}, claims interface{}) *MockIService_DiffRevisions_Call {
+	return &MockIService_DiffRevisions_Call{Call: _e.mock.On("DiffRevisions", id, from, to, claims)}
+}
+
+func (_c *MockIService_DiffRevisions_Call) Run(run func(id string, from int, to int, claims model.Claims)) *MockIService_DiffRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_DiffRevisions_Call) Return(revisionDiff model.RevisionDiff, err error) *MockIService_DiffRevisions_Call {
+	_c.Call.Return(revisionDiff, err)
+	return _c
+}
+
+func (_c *MockIService_DiffRevisions_Call) RunAndReturn(run func(id string, from int, to int, claims model.Claims) (model.RevisionDiff, error)) *MockIService_DiffRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Facets provides a mock function for the type MockIService
+func (_mock *MockIService) Facets(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	ret := _mock.Called(foodRecipeQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Facets")
+	}
+
+	var r0 model.FoodRecipeFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipeFacets, error)); ok {
+		return returnFunc(foodRecipeQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipeFacets); ok {
+		r0 = returnFunc(foodRecipeQuery)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipeFacets)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(foodRecipeQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Facets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Facets'
+type MockIService_Facets_Call struct {
+	*mock.Call
+}
+
+// Facets is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIService_Expecter) Facets(foodRecipeQuery interface{}) *MockIService_Facets_Call {
+	return &MockIService_Facets_Call{Call: _e.mock.On("Facets", foodRecipeQuery)}
+}
+
+func (_c *MockIService_Facets_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIService_Facets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Facets_Call) Return(foodRecipeFacets model.FoodRecipeFacets, err error) *MockIService_Facets_Call {
+	_c.Call.Return(foodRecipeFacets, err)
+	return _c
+}
+
+func (_c *MockIService_Facets_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error)) *MockIService_Facets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fork provides a mock function for the type MockIService
+func (_mock *MockIService) Fork(id string, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fork")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
+		r1 = returnFunc(id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Fork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fork'
+type MockIService_Fork_Call struct {
+	*mock.Call
+}
+
+// Fork is a helper method to define mock.On call
+//   - id string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Fork(id interface{}, claims interface{}) *MockIService_Fork_Call {
+	return &MockIService_Fork_Call{Call: _e.mock.On("Fork", id, claims)}
+}
+
+func (_c *MockIService_Fork_Call) Run(run func(id string, claims model.Claims)) *MockIService_Fork_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Fork_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Fork_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIService_Fork_Call) RunAndReturn(run func(id string, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Fork_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIService
+func (_mock *MockIService) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, pagination.Cursors, error) {
+	ret := _mock.Called(foodRecipeQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 pagination.Cursors
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, int64, pagination.Cursors, error)); ok {
+		return returnFunc(foodRecipeQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) int64); ok {
+		r1 = returnFunc(foodRecipeQuery)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery) pagination.Cursors); ok {
+		r2 = returnFunc(foodRecipeQuery)
+	} else {
+		r2 = ret.Get(2).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(3).(func(model.FoodRecipeQuery) error); ok {
+		r3 = returnFunc(foodRecipeQuery)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIService_Expecter) Get(foodRecipeQuery interface{}) *MockIService_Get_Call {
+	return &MockIService_Get_Call{Call: _e.mock.On("Get", foodRecipeQuery)}
+}
+
+func (_c *MockIService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, cursors pagination.Cursors, err error) *MockIService_Get_Call {
+	_c.Call.Return(foodRecipes, n, cursors, err)
+	return _c
+}
+
+func (_c *MockIService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, pagination.Cursors, error)) *MockIService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockIService
+func (_mock *MockIService) GetAll() ([]model.FoodRecipe, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
 	}
 
@@ -510,36 +2435,36 @@ func (_mock *MockIRepository) GetAll() ([]model.FoodRecipe, error) {
 	return r0, r1
 }
 
-// MockIRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
-type MockIRepository_GetAll_Call struct {
+// MockIService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockIService_GetAll_Call struct {
 	*mock.Call
 }
 
 // GetAll is a helper method to define mock.On call
-func (_e *MockIRepository_Expecter) GetAll() *MockIRepository_GetAll_Call {
-	return &MockIRepository_GetAll_Call{Call: _e.mock.On("GetAll")}
+func (_e *MockIService_Expecter) GetAll() *MockIService_GetAll_Call {
+	return &MockIService_GetAll_Call{Call: _e.mock.On("GetAll")}
 }
 
-func (_c *MockIRepository_GetAll_Call) Run(run func()) *MockIRepository_GetAll_Call {
+func (_c *MockIService_GetAll_Call) Run(run func()) *MockIService_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockIRepository_GetAll_Call) Return(foodRecipes []model.FoodRecipe, err error) *MockIRepository_GetAll_Call {
+func (_c *MockIService_GetAll_Call) Return(foodRecipes []model.FoodRecipe, err error) *MockIService_GetAll_Call {
 	_c.Call.Return(foodRecipes, err)
 	return _c
 }
 
-func (_c *MockIRepository_GetAll_Call) RunAndReturn(run func() ([]model.FoodRecipe, error)) *MockIRepository_GetAll_Call {
+func (_c *MockIService_GetAll_Call) RunAndReturn(run func() ([]model.FoodRecipe, error)) *MockIService_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) GetByID(id string) (model.FoodRecipe, error) {
-	ret := _mock.Called(id)
+// GetByID provides a mock function for the type MockIService
+func (_mock *MockIService) GetByID(id string, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -547,287 +2472,483 @@ func (_mock *MockIRepository) GetByID(id string) (model.FoodRecipe, error) {
 
 	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipe, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipe); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, claims)
 	} else {
 		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
+		r1 = returnFunc(id, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIRepository_GetByID_Call struct {
+// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIService_GetByID_Call struct {
 	*mock.Call
 }
 
 // GetByID is a helper method to define mock.On call
 //   - id string
-func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
-	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetByID(id interface{}, claims interface{}) *MockIService_GetByID_Call {
+	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id, claims)}
 }
 
-func (_c *MockIRepository_GetByID_Call) Run(run func(id string)) *MockIRepository_GetByID_Call {
+func (_c *MockIService_GetByID_Call) Run(run func(id string, claims model.Claims)) *MockIService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_GetByID_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id string, claims model.Claims) (model.FoodRecipe, error)) *MockIService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavorites provides a mock function for the type MockIService
+func (_mock *MockIService) GetFavorites(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error) {
+	ret := _mock.Called(foodRecipeQuery, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 pagination.Cursors
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)); ok {
+		return returnFunc(foodRecipeQuery, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, model.Claims) int64); ok {
+		r1 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery, model.Claims) pagination.Cursors); ok {
+		r2 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r2 = ret.Get(2).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(3).(func(model.FoodRecipeQuery, model.Claims) error); ok {
+		r3 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockIService_GetFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavorites'
+type MockIService_GetFavorites_Call struct {
+	*mock.Call
+}
+
+// GetFavorites is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetFavorites(foodRecipeQuery interface{}, claims interface{}) *MockIService_GetFavorites_Call {
+	return &MockIService_GetFavorites_Call{Call: _e.mock.On("GetFavorites", foodRecipeQuery, claims)}
+}
+
+func (_c *MockIService_GetFavorites_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims)) *MockIService_GetFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetFavorites_Call) Return(foodRecipes model.FoodRecipes, n int64, cursors pagination.Cursors, err error) *MockIService_GetFavorites_Call {
+	_c.Call.Return(foodRecipes, n, cursors, err)
+	return _c
+}
+
+func (_c *MockIService_GetFavorites_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)) *MockIService_GetFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForks provides a mock function for the type MockIService
+func (_mock *MockIService) GetForks(id string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error) {
+	ret := _mock.Called(id, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForks")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 pagination.Cursors
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.PageQuery, model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)); ok {
+		return returnFunc(id, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.PageQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(id, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.PageQuery, model.Claims) int64); ok {
+		r1 = returnFunc(id, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.PageQuery, model.Claims) pagination.Cursors); ok {
+		r2 = returnFunc(id, query, claims)
+	} else {
+		r2 = ret.Get(2).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(3).(func(string, model.PageQuery, model.Claims) error); ok {
+		r3 = returnFunc(id, query, claims)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockIService_GetForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForks'
+type MockIService_GetForks_Call struct {
+	*mock.Call
+}
+
+// GetForks is a helper method to define mock.On call
+//   - id string
+//   - query model.PageQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetForks(id interface{}, query interface{}, claims interface{}) *MockIService_GetForks_Call {
+	return &MockIService_GetForks_Call{Call: _e.mock.On("GetForks", id, query, claims)}
+}
+
+func (_c *MockIService_GetForks_Call) Run(run func(id string, query model.PageQuery, claims model.Claims)) *MockIService_GetForks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 model.PageQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PageQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIRepository_GetByID_Call {
-	_c.Call.Return(foodRecipe, err)
+func (_c *MockIService_GetForks_Call) Return(foodRecipes model.FoodRecipes, n int64, cursors pagination.Cursors, err error) *MockIService_GetForks_Call {
+	_c.Call.Return(foodRecipes, n, cursors, err)
 	return _c
 }
 
-func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id string) (model.FoodRecipe, error)) *MockIRepository_GetByID_Call {
+func (_c *MockIService_GetForks_Call) RunAndReturn(run func(id string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)) *MockIService_GetForks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Update(recipe *model.FoodRecipe) error {
-	ret := _mock.Called(recipe)
+// GetRevision provides a mock function for the type MockIService
+func (_mock *MockIService) GetRevision(id string, number int, claims model.Claims) (model.RecipeRevision, error) {
+	ret := _mock.Called(id, number, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetRevision")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
-		r0 = returnFunc(recipe)
+	var r0 model.RecipeRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int, model.Claims) (model.RecipeRevision, error)); ok {
+		return returnFunc(id, number, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int, model.Claims) model.RecipeRevision); ok {
+		r0 = returnFunc(id, number, claims)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.RecipeRevision)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string, int, model.Claims) error); ok {
+		r1 = returnFunc(id, number, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockIRepository_Update_Call struct {
+// MockIService_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockIService_GetRevision_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
-//   - recipe *model.FoodRecipe
-func (_e *MockIRepository_Expecter) Update(recipe interface{}) *MockIRepository_Update_Call {
-	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", recipe)}
+// GetRevision is a helper method to define mock.On call
+//   - id string
+//   - number int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetRevision(id interface{}, number interface{}, claims interface{}) *MockIService_GetRevision_Call {
+	return &MockIService_GetRevision_Call{Call: _e.mock.On("GetRevision", id, number, claims)}
 }
 
-func (_c *MockIRepository_Update_Call) Run(run func(recipe *model.FoodRecipe)) *MockIRepository_Update_Call {
+func (_c *MockIService_GetRevision_Call) Run(run func(id string, number int, claims model.Claims)) *MockIService_GetRevision_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.FoodRecipe
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(*model.FoodRecipe)
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
-	_c.Call.Return(err)
+func (_c *MockIService_GetRevision_Call) Return(recipeRevision model.RecipeRevision, err error) *MockIService_GetRevision_Call {
+	_c.Call.Return(recipeRevision, err)
 	return _c
 }
 
-func (_c *MockIRepository_Update_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockIRepository_Update_Call {
+func (_c *MockIService_GetRevision_Call) RunAndReturn(run func(id string, number int, claims model.Claims) (model.RecipeRevision, error)) *MockIService_GetRevision_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIService {
-	mock := &MockIService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockIService is an autogenerated mock type for the IService type
-type MockIService struct {
-	mock.Mock
-}
-
-type MockIService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockIService) EXPECT() *MockIService_Expecter {
-	return &MockIService_Expecter{mock: &_m.Mock}
-}
-
-// Count provides a mock function for the type MockIService
-func (_mock *MockIService) Count() (int64, error) {
-	ret := _mock.Called()
+// GetRevisions provides a mock function for the type MockIService
+func (_mock *MockIService) GetRevisions(id string, claims model.Claims) (model.RecipeRevisions, error) {
+	ret := _mock.Called(id, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Count")
+		panic("no return value specified for GetRevisions")
 	}
 
-	var r0 int64
+	var r0 model.RecipeRevisions
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.RecipeRevisions, error)); ok {
+		return returnFunc(id, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func() int64); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.RecipeRevisions); ok {
+		r0 = returnFunc(id, claims)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.RecipeRevisions)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
+		r1 = returnFunc(id, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
-type MockIService_Count_Call struct {
+// MockIService_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type MockIService_GetRevisions_Call struct {
 	*mock.Call
 }
 
-// Count is a helper method to define mock.On call
-func (_e *MockIService_Expecter) Count() *MockIService_Count_Call {
-	return &MockIService_Count_Call{Call: _e.mock.On("Count")}
+// GetRevisions is a helper method to define mock.On call
+//   - id string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetRevisions(id interface{}, claims interface{}) *MockIService_GetRevisions_Call {
+	return &MockIService_GetRevisions_Call{Call: _e.mock.On("GetRevisions", id, claims)}
 }
 
-func (_c *MockIService_Count_Call) Run(run func()) *MockIService_Count_Call {
+func (_c *MockIService_GetRevisions_Call) Run(run func(id string, claims model.Claims)) *MockIService_GetRevisions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockIService_Count_Call) Return(n int64, err error) *MockIService_Count_Call {
-	_c.Call.Return(n, err)
+func (_c *MockIService_GetRevisions_Call) Return(recipeRevisions model.RecipeRevisions, err error) *MockIService_GetRevisions_Call {
+	_c.Call.Return(recipeRevisions, err)
 	return _c
 }
 
-func (_c *MockIService_Count_Call) RunAndReturn(run func() (int64, error)) *MockIService_Count_Call {
+func (_c *MockIService_GetRevisions_Call) RunAndReturn(run func(id string, claims model.Claims) (model.RecipeRevisions, error)) *MockIService_GetRevisions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockIService
-func (_mock *MockIService) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
-	ret := _mock.Called(request, claims)
+// GetScaled provides a mock function for the type MockIService
+func (_mock *MockIService) GetScaled(id string, servings int, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, servings, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for GetScaled")
 	}
 
 	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error)); ok {
-		return returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(0).(func(string, int, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, servings, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) model.FoodRecipe); ok {
-		r0 = returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(0).(func(string, int, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, servings, claims)
 	} else {
 		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, model.Claims) error); ok {
-		r1 = returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(1).(func(string, int, model.Claims) error); ok {
+		r1 = returnFunc(id, servings, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIService_Create_Call struct {
+// MockIService_GetScaled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScaled'
+type MockIService_GetScaled_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - request dto.FoodRecipeRequest
+// GetScaled is a helper method to define mock.On call
+//   - id string
+//   - servings int
 //   - claims model.Claims
-func (_e *MockIService_Expecter) Create(request interface{}, claims interface{}) *MockIService_Create_Call {
-	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+func (_e *MockIService_Expecter) GetScaled(id interface{}, servings interface{}, claims interface{}) *MockIService_GetScaled_Call {
+	return &MockIService_GetScaled_Call{Call: _e.mock.On("GetScaled", id, servings, claims)}
 }
 
-func (_c *MockIService_Create_Call) Run(run func(request dto.FoodRecipeRequest, claims model.Claims)) *MockIService_Create_Call {
+func (_c *MockIService_GetScaled_Call) Run(run func(id string, servings int, claims model.Claims)) *MockIService_GetScaled_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 dto.FoodRecipeRequest
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(dto.FoodRecipeRequest)
+			arg0 = args[0].(string)
 		}
-		var arg1 model.Claims
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(model.Claims)
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_Create_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Create_Call {
+func (_c *MockIService_GetScaled_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_GetScaled_Call {
 	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Create_Call {
+func (_c *MockIService_GetScaled_Call) RunAndReturn(run func(id string, servings int, claims model.Claims) (model.FoodRecipe, error)) *MockIService_GetScaled_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockIService
-func (_mock *MockIService) Delete(id string, claims model.Claims) error {
-	ret := _mock.Called(id, claims)
+// Import provides a mock function for the type MockIService
+func (_mock *MockIService) Import(document []byte, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(document, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Import")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) error); ok {
-		r0 = returnFunc(id, claims)
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(document, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(document, claims)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func([]byte, model.Claims) error); ok {
+		r1 = returnFunc(document, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockIService_Delete_Call struct {
+// MockIService_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockIService_Import_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
-//   - id string
+// Import is a helper method to define mock.On call
+//   - document []byte
 //   - claims model.Claims
-func (_e *MockIService_Expecter) Delete(id interface{}, claims interface{}) *MockIService_Delete_Call {
-	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+func (_e *MockIService_Expecter) Import(document interface{}, claims interface{}) *MockIService_Import_Call {
+	return &MockIService_Import_Call{Call: _e.mock.On("Import", document, claims)}
 }
 
-func (_c *MockIService_Delete_Call) Run(run func(id string, claims model.Claims)) *MockIService_Delete_Call {
+func (_c *MockIService_Import_Call) Run(run func(document []byte, claims model.Claims)) *MockIService_Import_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 []byte
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].([]byte)
 		}
 		var arg1 model.Claims
 		if args[1] != nil {
@@ -841,103 +2962,99 @@ func (_c *MockIService_Delete_Call) Run(run func(id string, claims model.Claims)
 	return _c
 }
 
-func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockIService_Import_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Import_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIService_Delete_Call) RunAndReturn(run func(id string, claims model.Claims) error) *MockIService_Delete_Call {
+func (_c *MockIService_Import_Call) RunAndReturn(run func(document []byte, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Import_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function for the type MockIService
-func (_mock *MockIService) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
-	ret := _mock.Called(foodRecipeQuery)
+// Publish provides a mock function for the type MockIService
+func (_mock *MockIService) Publish(id string, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for Publish")
 	}
 
-	var r0 model.FoodRecipes
-	var r1 int64
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, int64, error)); ok {
-		return returnFunc(foodRecipeQuery)
-	}
-	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
-		r0 = returnFunc(foodRecipeQuery)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.FoodRecipes)
-		}
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, claims)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) int64); ok {
-		r1 = returnFunc(foodRecipeQuery)
+	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, claims)
 	} else {
-		r1 = ret.Get(1).(int64)
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery) error); ok {
-		r2 = returnFunc(foodRecipeQuery)
+	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
+		r1 = returnFunc(id, claims)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockIService_Get_Call struct {
+// MockIService_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockIService_Publish_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - foodRecipeQuery model.FoodRecipeQuery
-func (_e *MockIService_Expecter) Get(foodRecipeQuery interface{}) *MockIService_Get_Call {
-	return &MockIService_Get_Call{Call: _e.mock.On("Get", foodRecipeQuery)}
+// Publish is a helper method to define mock.On call
+//   - id string
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Publish(id interface{}, claims interface{}) *MockIService_Publish_Call {
+	return &MockIService_Publish_Call{Call: _e.mock.On("Publish", id, claims)}
 }
 
-func (_c *MockIService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIService_Get_Call {
+func (_c *MockIService_Publish_Call) Run(run func(id string, claims model.Claims)) *MockIService_Publish_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.FoodRecipeQuery
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(model.FoodRecipeQuery)
+			arg0 = args[0].(string)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, err error) *MockIService_Get_Call {
-	_c.Call.Return(foodRecipes, n, err)
+func (_c *MockIService_Publish_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Publish_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error)) *MockIService_Get_Call {
+func (_c *MockIService_Publish_Call) RunAndReturn(run func(id string, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Publish_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function for the type MockIService
-func (_mock *MockIService) GetAll() ([]model.FoodRecipe, error) {
+// Relabel provides a mock function for the type MockIService
+func (_mock *MockIService) Relabel() (int, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAll")
+		panic("no return value specified for Relabel")
 	}
 
-	var r0 []model.FoodRecipe
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]model.FoodRecipe, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func() (int, error)); ok {
 		return returnFunc()
 	}
-	if returnFunc, ok := ret.Get(0).(func() []model.FoodRecipe); ok {
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
 		r0 = returnFunc()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.FoodRecipe)
-		}
+		r0 = ret.Get(0).(int)
 	}
 	if returnFunc, ok := ret.Get(1).(func() error); ok {
 		r1 = returnFunc()
@@ -947,89 +3064,101 @@ func (_mock *MockIService) GetAll() ([]model.FoodRecipe, error) {
 	return r0, r1
 }
 
-// MockIService_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
-type MockIService_GetAll_Call struct {
+// MockIService_Relabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Relabel'
+type MockIService_Relabel_Call struct {
 	*mock.Call
 }
 
-// GetAll is a helper method to define mock.On call
-func (_e *MockIService_Expecter) GetAll() *MockIService_GetAll_Call {
-	return &MockIService_GetAll_Call{Call: _e.mock.On("GetAll")}
+// Relabel is a helper method to define mock.On call
+func (_e *MockIService_Expecter) Relabel() *MockIService_Relabel_Call {
+	return &MockIService_Relabel_Call{Call: _e.mock.On("Relabel")}
 }
 
-func (_c *MockIService_GetAll_Call) Run(run func()) *MockIService_GetAll_Call {
+func (_c *MockIService_Relabel_Call) Run(run func()) *MockIService_Relabel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockIService_GetAll_Call) Return(foodRecipes []model.FoodRecipe, err error) *MockIService_GetAll_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIService_Relabel_Call) Return(n int, err error) *MockIService_Relabel_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockIService_GetAll_Call) RunAndReturn(run func() ([]model.FoodRecipe, error)) *MockIService_GetAll_Call {
+func (_c *MockIService_Relabel_Call) RunAndReturn(run func() (int, error)) *MockIService_Relabel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockIService
-func (_mock *MockIService) GetByID(id string) (model.FoodRecipe, error) {
-	ret := _mock.Called(id)
+// Revert provides a mock function for the type MockIService
+func (_mock *MockIService) Revert(id string, number int, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, number, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for Revert")
 	}
 
 	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipe, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(string, int, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, number, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipe); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(string, int, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, number, claims)
 	} else {
 		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(string, int, model.Claims) error); ok {
+		r1 = returnFunc(id, number, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIService_GetByID_Call struct {
+// MockIService_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type MockIService_Revert_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Revert is a helper method to define mock.On call
 //   - id string
-func (_e *MockIService_Expecter) GetByID(id interface{}) *MockIService_GetByID_Call {
-	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+//   - number int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Revert(id interface{}, number interface{}, claims interface{}) *MockIService_Revert_Call {
+	return &MockIService_Revert_Call{Call: _e.mock.On("Revert", id, number, claims)}
 }
 
-func (_c *MockIService_GetByID_Call) Run(run func(id string)) *MockIService_GetByID_Call {
+func (_c *MockIService_Revert_Call) Run(run func(id string, number int, claims model.Claims)) *MockIService_Revert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_GetByID_Call {
+func (_c *MockIService_Revert_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIService_Revert_Call {
 	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id string) (model.FoodRecipe, error)) *MockIService_GetByID_Call {
+func (_c *MockIService_Revert_Call) RunAndReturn(run func(id string, number int, claims model.Claims) (model.FoodRecipe, error)) *MockIService_Revert_Call {
 	_c.Call.Return(run)
 	return _c
 }
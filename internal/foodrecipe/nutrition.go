@@ -0,0 +1,99 @@
+package foodrecipe
+
+import (
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/nutrition"
+	"github.com/klins/devpool/go-day6/wongnok/internal/units"
+)
+
+const (
+	reasonUnknownIngredient = "unknown ingredient"
+	reasonMissingQuantity   = "missing quantity"
+	reasonUnknownUnit       = "cannot convert unit to grams"
+)
+
+// pieceUnits count whole items, weighed through the reference piece weight.
+var pieceUnits = map[string]bool{
+	"":       true,
+	"ฟอง":    true,
+	"ลูก":    true,
+	"หัว":    true,
+	"กลีบ":   true,
+	"ต้น":    true,
+	"ใบ":     true,
+	"เม็ด":   true,
+	"ตัว":    true,
+	"pc":     true,
+	"pcs":    true,
+	"piece":  true,
+	"pieces": true,
+	"clove":  true,
+	"cloves": true,
+	"whole":  true,
+}
+
+// EstimateNutrition sums the nutrients of the recipe's structured ingredients
+// and divides them by its servings. Ingredients it cannot weigh or find in the
+// reference table are skipped and listed in Unmatched. Recipes without
+// structured ingredients have no estimate.
+func EstimateNutrition(recipe model.FoodRecipe) *model.Nutrition {
+	if len(recipe.Ingredients) == 0 {
+		return nil
+	}
+
+	servings := recipe.Servings
+	if servings <= 0 {
+		servings = 1
+	}
+
+	var (
+		total     nutrition.Nutrients
+		unmatched = make([]model.UnmatchedIngredient, 0)
+	)
+	for _, ingredient := range recipe.Ingredients {
+		entry, found := nutrition.Match(ingredient.Name)
+		if !found {
+			unmatched = append(unmatched, model.UnmatchedIngredient{Name: ingredient.Name, Reason: reasonUnknownIngredient})
+			continue
+		}
+
+		if ingredient.Quantity == nil {
+			unmatched = append(unmatched, model.UnmatchedIngredient{Name: ingredient.Name, Reason: reasonMissingQuantity})
+			continue
+		}
+
+		grams, ok := weigh(*ingredient.Quantity, ingredient.Unit, ingredient.Name, entry)
+		if !ok {
+			unmatched = append(unmatched, model.UnmatchedIngredient{Name: ingredient.Name, Reason: reasonUnknownUnit})
+			continue
+		}
+
+		total = total.Add(entry.ForGrams(grams))
+	}
+
+	return &model.Nutrition{
+		Servings:   servings,
+		PerServing: total.Scale(1 / float64(servings)),
+		Unmatched:  unmatched,
+	}
+}
+
+func weigh(quantity float64, unit string, name string, entry nutrition.Entry) (float64, bool) {
+	unit = strings.ToLower(strings.TrimSpace(unit))
+
+	if pieceUnits[unit] {
+		if entry.PieceGrams <= 0 {
+			return 0, false
+		}
+		return quantity * entry.PieceGrams, true
+	}
+
+	// The reference name helps the density lookup for Thai-only names
+	grams, err := units.ToGrams(quantity, unit, name+" "+entry.Name)
+	if err != nil {
+		return 0, false
+	}
+	return grams, true
+}
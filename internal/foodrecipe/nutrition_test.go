@@ -0,0 +1,56 @@
+package foodrecipe_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateNutrition(t *testing.T) {
+	quantity := func(value float64) *float64 { return &value }
+
+	t.Run("ShouldDivideTotalByServings", func(t *testing.T) {
+		recipe := model.FoodRecipe{
+			Servings: 2,
+			Ingredients: model.RecipeIngredients{
+				{Name: "ไข่ไก่", Quantity: quantity(2), Unit: "ฟอง"},
+				{Name: "น้ำปลา", Quantity: quantity(1), Unit: "ช้อนโต๊ะ"},
+			},
+		}
+
+		facts := foodrecipe.EstimateNutrition(recipe)
+
+		// 100 g of egg and 18 g of fish sauce split in two
+		assert.Equal(t, 2, facts.Servings)
+		assert.InDelta(t, (143+35*0.18)/2, facts.PerServing.Calories, 0.01)
+		assert.InDelta(t, (142+7851*0.18)/2, facts.PerServing.Sodium, 0.01)
+		assert.Empty(t, facts.Unmatched)
+	})
+
+	t.Run("ShouldFlagIngredientsItCannotEstimate", func(t *testing.T) {
+		recipe := model.FoodRecipe{
+			Servings: 1,
+			Ingredients: model.RecipeIngredients{
+				{Name: "Dragon fruit", Quantity: quantity(1)},
+				{Name: "Salt"},
+				{Name: "Basil", Quantity: quantity(1), Unit: "cup"},
+				{Name: "Pork", Quantity: quantity(200), Unit: "g"},
+			},
+		}
+
+		facts := foodrecipe.EstimateNutrition(recipe)
+
+		assert.Equal(t, []model.UnmatchedIngredient{
+			{Name: "Dragon fruit", Reason: "unknown ingredient"},
+			{Name: "Salt", Reason: "missing quantity"},
+			{Name: "Basil", Reason: "cannot convert unit to grams"},
+		}, facts.Unmatched)
+		assert.InDelta(t, 484, facts.PerServing.Calories, 0.01)
+	})
+
+	t.Run("ShouldReturnNilWithoutStructuredIngredients", func(t *testing.T) {
+		assert.Nil(t, foodrecipe.EstimateNutrition(model.FoodRecipe{Ingredient: "2 eggs"}))
+	})
+}
@@ -0,0 +1,53 @@
+package foodrecipe
+
+import (
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+)
+
+// IncompleteError lists what a recipe still needs before it can be published.
+type IncompleteError struct {
+	Missing []string
+}
+
+func (err IncompleteError) Error() string {
+	return "recipe is incomplete, missing " + strings.Join(err.Missing, ", ")
+}
+
+// CheckComplete returns an IncompleteError when the recipe lacks something a
+// reader needs: a description, ingredients and steps. Legacy text counts for
+// ingredients and steps.
+func CheckComplete(recipe model.FoodRecipe) error {
+	var missing []string
+
+	if strings.TrimSpace(recipe.Name) == "" {
+		missing = append(missing, "name")
+	}
+
+	if strings.TrimSpace(recipe.Description) == "" {
+		missing = append(missing, "description")
+	}
+
+	if len(recipe.Ingredients) == 0 && strings.TrimSpace(recipe.Ingredient) == "" {
+		missing = append(missing, "ingredients")
+	}
+
+	if len(recipe.Steps) == 0 && strings.TrimSpace(recipe.Instruction) == "" {
+		missing = append(missing, "steps")
+	}
+
+	if len(missing) > 0 {
+		return IncompleteError{Missing: missing}
+	}
+	return nil
+}
+
+// checkListed runs CheckComplete on a recipe that anyone can open, published
+// or shared by link, however it got there.
+func checkListed(recipe model.FoodRecipe) error {
+	if recipe.Status != model.RecipeStatusPublished && recipe.Status != model.RecipeStatusUnlisted {
+		return nil
+	}
+	return CheckComplete(recipe)
+}
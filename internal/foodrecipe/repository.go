@@ -1,7 +1,13 @@
 package foodrecipe
 
 import (
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -9,13 +15,25 @@ import (
 type IRepository interface {
 	Create(recipe *model.FoodRecipe) error
 	GetByID(id string) (model.FoodRecipe, error)
+	GetByIDs(ids []uint) (model.FoodRecipes, error)
 	GetAll() ([]model.FoodRecipe, error)
-	Get(query model.FoodRecipeQuery) (model.FoodRecipes, error)
-	GetFavorites(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, error)
-	Count() (int64, error)
-	CountFavorites(userID string) (int64, error)
-	Update(recipe *model.FoodRecipe) error
+	Get(query model.FoodRecipeQuery) (model.FoodRecipes, pagination.Cursors, error)
+	GetFavorites(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, pagination.Cursors, error)
+	Count(query model.FoodRecipeQuery) (int64, error)
+	CountFavorites(query model.FoodRecipeQuery, userID string) (int64, error)
+	Facets(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error)
+	Update(recipe *model.FoodRecipe, editorID string) error
 	Delete(id string) error
+	CountTags(ids []uint) (int64, error)
+	GetCookingDurations() ([]model.CookingDuration, error)
+	GetDifficulties() ([]model.Difficulty, error)
+	UpdateDerived(recipe *model.FoodRecipe) error
+	UpdateStatus(recipe *model.FoodRecipe) error
+	GetAttribution(recipeID uint) (model.RecipeAttributions, error)
+	GetForks(recipeID uint, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)
+	CountForks(recipeID uint) (int64, error)
+	GetRevisions(recipeID uint) (model.RecipeRevisions, error)
+	GetRevision(recipeID uint, number int) (model.RecipeRevision, error)
 }
 
 type Repository struct {
@@ -28,81 +46,566 @@ func NewRepository(db *gorm.DB) IRepository {
 	}
 }
 
+// preloadAssociations loads every relation of a recipe, keeping structured
+// ingredients and steps in the order the author wrote them.
+func preloadAssociations(db *gorm.DB) *gorm.DB {
+	byPosition := func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	}
+
+	return db.Preload(clause.Associations).
+		Preload("Ingredients", byPosition).
+		Preload("Steps", byPosition).
+		Preload("Tags.TagGroup").
+		Preload("Allergens", func(db *gorm.DB) *gorm.DB {
+			return db.Order("allergen asc")
+		})
+}
+
+// dietColumns maps the ?diet= filter values to their flag columns.
+var dietColumns = map[string]string{
+	"vegetarian":     "food_recipes.vegetarian",
+	"vegan":          "food_recipes.vegan",
+	"halal-friendly": "food_recipes.halal_friendly",
+	"gluten-free":    "food_recipes.gluten_free",
+}
+
+// sortName resolves ?sort=. Relevance only applies when searching, and is
+// also the default then; otherwise recipes are sorted by name.
+func sortName(query model.FoodRecipeQuery) string {
+	searching := search.Query(query.Search) != ""
+
+	switch {
+	case query.Sort == "" && searching:
+		return "relevance"
+	case query.Sort == "", query.Sort == "relevance" && !searching:
+		return "name"
+	}
+	return query.Sort
+}
+
+// sortRecipes returns the keyset order of ?sort=. Favorite counts are
+// computed in SQL and rating summaries kept on the recipe, so the page is cut
+// after sorting.
+func sortRecipes(query model.FoodRecipeQuery) pagination.Order {
+	switch sortName(query) {
+	case "relevance":
+		return pagination.Order{
+			Table: "food_recipes",
+			SQL:   "ts_rank(food_recipes.search_vector, to_tsquery('simple', ?))",
+			Vars:  []any{search.Query(query.Search)},
+			Type:  "REAL",
+			Desc:  true,
+		}
+	case "newest":
+		return pagination.Order{Table: "food_recipes", SQL: "food_recipes.created_at", Type: "TIMESTAMP", Desc: true}
+	case "oldest":
+		return pagination.Order{Table: "food_recipes", SQL: "food_recipes.created_at", Type: "TIMESTAMP"}
+	case "top-rated":
+		// The Bayesian score, so a single 5 stars does not outrank many 4.8s
+		return pagination.Order{Table: "food_recipes", SQL: "food_recipes.rating_score", Type: "DOUBLE PRECISION", Desc: true}
+	case "most-rated":
+		return pagination.Order{Table: "food_recipes", SQL: "food_recipes.rating_count", Type: "INT", Desc: true}
+	case "most-favorited":
+		return pagination.Order{Table: "food_recipes", SQL: `(
+			SELECT COUNT(*) FROM favorites
+			WHERE favorites.food_recipe_id = food_recipes.id AND favorites.deleted_at IS NULL
+		)`, Type: "BIGINT", Desc: true}
+	}
+
+	return pagination.Order{Table: "food_recipes", SQL: "food_recipes.name", Type: "TEXT"}
+}
+
+// pageRecipes decodes the cursor of a recipe listing and returns the scope
+// that orders and cuts the page. A cursor only fits the sort it was made for.
+func pageRecipes(query model.FoodRecipeQuery) (*pagination.Cursor, func(db *gorm.DB) *gorm.DB, error) {
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cursor != nil && cursor.Sort != sortName(query) {
+		return nil, nil, errors.Wrap(global.ErrorInvalidCursor, "cursor of another sort")
+	}
+
+	limit := query.Size(pagination.DefaultLimit)
+	return cursor, pagination.Scope(sortRecipes(query), cursor, limit, query.Offset(limit)), nil
+}
+
+// paginateRecipes cuts the fetched recipes down to the page and builds its
+// cursors.
+func paginateRecipes(recipes model.FoodRecipes, query model.FoodRecipeQuery, cursor *pagination.Cursor) (model.FoodRecipes, pagination.Cursors) {
+	limit := query.Size(pagination.DefaultLimit)
+	sort := sortName(query)
+
+	return pagination.Paginate(recipes, limit, cursor, query.Offset(limit) > 0, func(recipe model.FoodRecipe) pagination.Cursor {
+		return pagination.Cursor{Sort: sort, Key: recipe.SortKey, ID: recipe.ID}
+	})
+}
+
+// publishedRecipes keeps the recipes listed to everyone.
+func publishedRecipes(db *gorm.DB) *gorm.DB {
+	return db.Where("food_recipes.status = ?", model.RecipeStatusPublished)
+}
+
+// visibleRecipes keeps the recipes the user may open: the published and
+// unlisted ones, and their own. See model.FoodRecipe.VisibleTo.
+func visibleRecipes(userID string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(
+			"(food_recipes.status IN ? OR food_recipes.user_id = ?)",
+			[]string{model.RecipeStatusPublished, model.RecipeStatusUnlisted}, userID,
+		)
+	}
+}
+
+// filterRecipes applies the list filters shared by every recipe listing.
+func filterRecipes(query model.FoodRecipeQuery) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if tsquery := search.Query(query.Search); tsquery != "" {
+			db = db.Where("food_recipes.search_vector @@ to_tsquery('simple', ?)", tsquery)
+		}
+
+		if query.DifficultyID != 0 {
+			db = db.Where("food_recipes.difficulty_id = ?", query.DifficultyID)
+		}
+
+		if query.CookingDurationID != 0 {
+			db = db.Where("food_recipes.cooking_duration_id = ?", query.CookingDurationID)
+		}
+
+		if query.UserID != "" {
+			db = db.Where("food_recipes.user_id = ?", query.UserID)
+		}
+
+		if query.CreatedFrom != nil {
+			db = db.Where("food_recipes.created_at >= ?", *query.CreatedFrom)
+		}
+
+		if query.CreatedTo != nil {
+			db = db.Where("food_recipes.created_at < ?", query.CreatedTo.AddDate(0, 0, 1))
+		}
+
+		if query.MinRating > 0 {
+			db = db.Where("food_recipes.average_rating >= ?", query.MinRating)
+		}
+
+		for _, diet := range query.Diets {
+			if column, ok := dietColumns[diet]; ok {
+				db = db.Where(column + " = TRUE")
+			}
+		}
+
+		if len(query.ExcludeAllergens) > 0 {
+			db = db.Where(`NOT EXISTS (
+				SELECT 1 FROM recipe_allergens
+				WHERE recipe_allergens.food_recipe_id = food_recipes.id
+					AND recipe_allergens.allergen IN ?
+					AND COALESCE(recipe_allergens.override, recipe_allergens.detected)
+			)`, query.ExcludeAllergens)
+		}
+
+		return db
+	}
+}
+
 func (repo Repository) Create(recipe *model.FoodRecipe) error {
-	return repo.DB.Preload(clause.Associations).Create(recipe).First(&recipe).Error
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		// Ingredients are created along with the recipe as a has-many association.
+		// Tags already exist, so only the recipe_tags rows are written.
+		if err := tx.Omit("Tags.*").Create(recipe).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Scopes(preloadAssociations).First(recipe, recipe.ID).Error; err != nil {
+			return err
+		}
+
+		return createRevision(tx, *recipe, recipe.UserID, recipe.CreatedAt)
+	})
 }
 
 func (repo Repository) GetByID(id string) (model.FoodRecipe, error) {
 	var recipe model.FoodRecipe
-	err := repo.DB.Preload(clause.Associations).First(&recipe, "id = ?", id).Error
+	err := repo.DB.Scopes(preloadAssociations).First(&recipe, "id = ?", id).Error
 	return recipe, err
 }
 
+// GetByIDs returns the recipes that still exist out of ids, in no particular
+// order. Deleted ones are left out.
+func (repo Repository) GetByIDs(ids []uint) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+	if len(ids) == 0 {
+		return recipes, nil
+	}
+
+	err := repo.DB.Scopes(preloadAssociations).Where("id IN ?", ids).Find(&recipes).Error
+	return recipes, err
+}
+
 func (repo Repository) GetAll() ([]model.FoodRecipe, error) {
 	var recipes []model.FoodRecipe
-	err := repo.DB.Preload(clause.Associations).Find(&recipes).Error
+	err := repo.DB.Scopes(preloadAssociations).Find(&recipes).Error
 	return recipes, err
 }
 
-func (repo Repository) Get(query model.FoodRecipeQuery) (model.FoodRecipes, error) {
+func (repo Repository) Get(query model.FoodRecipeQuery) (model.FoodRecipes, pagination.Cursors, error) {
 	var recipes = make(model.FoodRecipes, 0)
 
-	offset := (query.Page - 1) * query.Limit
-	db := repo.DB.Preload(clause.Associations)
-
-	if query.Search != "" {
-		db = db.Where("name LIKE ?", "%"+query.Search+"%").Or("description LIKE ?", "%"+query.Search+"%")
+	cursor, page, err := pageRecipes(query)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
 	}
 
-	if err := db.Order("name asc").Limit(query.Limit).Offset(offset).Find(&recipes).Error; err != nil {
-		return nil, err
+	db := repo.DB.Scopes(preloadAssociations, publishedRecipes, filterRecipes(query), page)
+	if err := db.Find(&recipes).Error; err != nil {
+		return nil, pagination.Cursors{}, err
 	}
 
-	return recipes, nil
+	recipes, cursors := paginateRecipes(recipes, query, cursor)
+	return recipes, cursors, nil
 }
 
-func (repo Repository) GetFavorites(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, error) {
+func (repo Repository) GetFavorites(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, pagination.Cursors, error) {
 	var recipes = make(model.FoodRecipes, 0)
 
-	offset := (query.Page - 1) * query.Limit
-	db := repo.DB.Preload(clause.Associations).
+	cursor, page, err := pageRecipes(query)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	db := repo.DB.Scopes(preloadAssociations, visibleRecipes(userID), filterRecipes(query), page).
 		Joins("JOIN favorites ON favorites.food_recipe_id = food_recipes.id").
 		Where("favorites.user_id = ? AND favorites.deleted_at IS NULL", userID)
-	if query.Search != "" {
-		db = db.Where("food_recipes.name LIKE ?", "%"+query.Search+"%").Or("food_recipes.description LIKE ?", "%"+query.Search+"%")
+	if err := db.Find(&recipes).Error; err != nil {
+		return nil, pagination.Cursors{}, err
 	}
-	if err := db.Order("food_recipes.name asc").Limit(query.Limit).Offset(offset).Find(&recipes).Error; err != nil {
-		return nil, err
-	}
-	return recipes, nil
+
+	recipes, cursors := paginateRecipes(recipes, query, cursor)
+	return recipes, cursors, nil
 }
 
-func (repo Repository) Count() (int64, error) {
+func (repo Repository) Count(query model.FoodRecipeQuery) (int64, error) {
 	var count int64
-	err := repo.DB.Model(&model.FoodRecipe{}).Count(&count).Error
+	err := repo.DB.Model(&model.FoodRecipe{}).Scopes(publishedRecipes, filterRecipes(query)).Count(&count).Error
 	return count, err
 }
 
-func (repo Repository) CountFavorites(userID string) (int64, error) {
+func (repo Repository) CountFavorites(query model.FoodRecipeQuery, userID string) (int64, error) {
 	var count int64
 
 	// Get count of favorite recipes for the user with null deleted_at
-	err := repo.DB.Model(&model.FoodRecipe{}).
+	err := repo.DB.Model(&model.FoodRecipe{}).Scopes(visibleRecipes(userID), filterRecipes(query)).
 		Joins("JOIN favorites ON favorites.food_recipe_id = food_recipes.id").
 		Where("favorites.user_id = ? AND favorites.deleted_at IS NULL", userID).
 		Count(&count).Error
 	return count, err
 }
 
-func (repo Repository) Update(recipe *model.FoodRecipe) error {
-	// update
-	if err := repo.DB.Model(&recipe).Updates(recipe).Error; err != nil {
+// Facets counts the recipes matching the query per difficulty and per cooking
+// duration. Values without matching recipes are left out.
+func (repo Repository) Facets(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	var facets model.FoodRecipeFacets
+
+	err := repo.DB.Model(&model.FoodRecipe{}).Scopes(publishedRecipes, filterRecipes(query)).
+		Select("difficulties.id, difficulties.name, COUNT(food_recipes.id) AS count").
+		Joins("JOIN difficulties ON difficulties.id = food_recipes.difficulty_id").
+		Group("difficulties.id, difficulties.name").
+		Order("difficulties.id asc").
+		Scan(&facets.Difficulties).Error
+	if err != nil {
+		return model.FoodRecipeFacets{}, err
+	}
+
+	err = repo.DB.Model(&model.FoodRecipe{}).Scopes(publishedRecipes, filterRecipes(query)).
+		Select("cooking_durations.id, cooking_durations.name, COUNT(food_recipes.id) AS count").
+		Joins("JOIN cooking_durations ON cooking_durations.id = food_recipes.cooking_duration_id").
+		Group("cooking_durations.id, cooking_durations.name").
+		Order("cooking_durations.id asc").
+		Scan(&facets.CookingDurations).Error
+	if err != nil {
+		return model.FoodRecipeFacets{}, err
+	}
+
+	return facets, nil
+}
+
+// Update saves the recipe and keeps the result as a new revision by the
+// editor.
+func (repo Repository) Update(recipe *model.FoodRecipe, editorID string) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := keepOriginal(tx, recipe.ID); err != nil {
+			return err
+		}
+
+		if err := tx.Model(recipe).Omit(clause.Associations).Updates(recipe).Error; err != nil {
+			return err
+		}
+
+		// nil means the client did not send them, so leave them as they are
+		if recipe.Ingredients != nil {
+			if err := replaceIngredients(tx, recipe.ID, recipe.Ingredients); err != nil {
+				return err
+			}
+		}
+
+		if recipe.Steps != nil {
+			if err := replaceSteps(tx, recipe.ID, recipe.Steps); err != nil {
+				return err
+			}
+		}
+
+		if err := updateDerived(tx, recipe); err != nil {
+			return err
+		}
+
+		if recipe.Tags != nil {
+			if err := tx.Model(recipe).Omit("Tags.*").Association("Tags").Replace(recipe.Tags); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Scopes(preloadAssociations).First(recipe, recipe.ID).Error; err != nil {
+			return err
+		}
+
+		return createRevision(tx, *recipe, editorID, recipe.UpdatedAt)
+	})
+}
+
+// keepOriginal records the recipe as it is as its first revision, when it has
+// none yet because it was created before revisions were kept.
+func keepOriginal(tx *gorm.DB, recipeID uint) error {
+	var count int64
+	if err := tx.Model(&model.RecipeRevision{}).Where("food_recipe_id = ?", recipeID).Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	var original model.FoodRecipe
+	if err := tx.Scopes(preloadAssociations).First(&original, recipeID).Error; err != nil {
+		return err
+	}
+
+	return createRevision(tx, original, original.UserID, original.UpdatedAt)
+}
+
+// createRevision numbers the revision after the latest one of the recipe. Two
+// edits racing for a number fail on the unique index rather than overwrite.
+func createRevision(tx *gorm.DB, recipe model.FoodRecipe, editorID string, at time.Time) error {
+	var latest int
+	err := tx.Model(&model.RecipeRevision{}).
+		Select("COALESCE(MAX(number), 0)").
+		Where("food_recipe_id = ?", recipe.ID).
+		Scan(&latest).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Omit(clause.Associations).Create(&model.RecipeRevision{
+		FoodRecipeID: recipe.ID,
+		Number:       latest + 1,
+		UserID:       editorID,
+		Snapshot:     recipe.ToRequest(),
+		CreatedAt:    at,
+	}).Error
+}
+
+// GetRevisions returns the revisions of a recipe, latest first.
+func (repo Repository) GetRevisions(recipeID uint) (model.RecipeRevisions, error) {
+	var revisions = make(model.RecipeRevisions, 0)
+	err := repo.DB.Preload("User").
+		Where("food_recipe_id = ?", recipeID).
+		Order("number desc").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+func (repo Repository) GetRevision(recipeID uint, number int) (model.RecipeRevision, error) {
+	var revision model.RecipeRevision
+	err := repo.DB.Preload("User").
+		First(&revision, "food_recipe_id = ? AND number = ?", recipeID, number).Error
+	return revision, err
+}
+
+// maxForkDepth bounds the walk up a fork chain.
+const maxForkDepth = 50
+
+// GetAttribution walks up the fork chain of a recipe, nearest first. Deleted
+// recipes stay in the chain, since their authors are still credited.
+func (repo Repository) GetAttribution(recipeID uint) (model.RecipeAttributions, error) {
+	var attributions = make(model.RecipeAttributions, 0)
+
+	err := repo.DB.Raw(`
+		WITH RECURSIVE chain AS (
+			SELECT parent.id, parent.forked_from_id, 1 AS depth
+			FROM food_recipes parent
+			JOIN food_recipes fork ON fork.forked_from_id = parent.id
+			WHERE fork.id = ?
+			UNION ALL
+			SELECT parent.id, parent.forked_from_id, chain.depth + 1
+			FROM food_recipes parent
+			JOIN chain ON chain.forked_from_id = parent.id
+			WHERE chain.depth < ?
+		)
+		SELECT
+			food_recipes.id AS food_recipe_id,
+			food_recipes.name,
+			food_recipes.status,
+			food_recipes.deleted_at IS NOT NULL AS deleted,
+			food_recipes.user_id,
+			COALESCE(users.first_name, '') AS first_name,
+			COALESCE(users.last_name, '') AS last_name,
+			COALESCE(users.image_url, '') AS image_url
+		FROM chain
+		JOIN food_recipes ON food_recipes.id = chain.id
+		LEFT JOIN users ON users.id = food_recipes.user_id
+		ORDER BY chain.depth
+	`, recipeID, maxForkDepth).Scan(&attributions).Error
+
+	return attributions, err
+}
+
+// GetForks returns the published forks of a recipe, newest first.
+func (repo Repository) GetForks(recipeID uint, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	limit := query.Size(pagination.DefaultLimit)
+	order := pagination.Order{Table: "food_recipes", Desc: true}
+
+	err = repo.DB.Scopes(preloadAssociations, publishedRecipes, pagination.Scope(order, cursor, limit, query.Offset(limit))).
+		Where("food_recipes.forked_from_id = ?", recipeID).
+		Find(&recipes).Error
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	recipes, cursors := pagination.Paginate(recipes, limit, cursor, query.Offset(limit) > 0, func(recipe model.FoodRecipe) pagination.Cursor {
+		return pagination.Cursor{ID: recipe.ID}
+	})
+	return recipes, cursors, nil
+}
+
+func (repo Repository) CountForks(recipeID uint) (int64, error) {
+	var count int64
+	err := repo.DB.Model(&model.FoodRecipe{}).Scopes(publishedRecipes).
+		Where("food_recipes.forked_from_id = ?", recipeID).
+		Count(&count).Error
+	return count, err
+}
+
+// UpdateStatus writes only the status, which is not part of the revisions.
+func (repo Repository) UpdateStatus(recipe *model.FoodRecipe) error {
+	return repo.DB.Model(recipe).Update("status", recipe.Status).Error
+}
+
+func (repo Repository) UpdateDerived(recipe *model.FoodRecipe) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := updateDerived(tx, recipe); err != nil {
+			return err
+		}
+
+		// Updates replace the ingredients, so only a relabel has their words
+		// to write
+		for _, ingredient := range recipe.Ingredients {
+			if err := tx.Model(&ingredient).UpdateColumn("search_name", ingredient.SearchName).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// updateDerived writes the labels and search columns explicitly, since Updates
+// skips the false flags and cleared overrides.
+func updateDerived(tx *gorm.DB, recipe *model.FoodRecipe) error {
+	err := tx.Model(recipe).
+		Select(
+			"vegetarian", "vegan", "halal_friendly", "gluten_free",
+			"override_vegetarian", "override_vegan", "override_halal_friendly", "override_gluten_free",
+			"search_title", "search_body",
+		).
+		Updates(recipe).Error
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Where("food_recipe_id = ?", recipe.ID).Delete(&model.RecipeAllergen{}).Error; err != nil {
 		return err
 	}
 
-	return repo.DB.Preload(clause.Associations).First(&recipe, recipe.ID).Error
+	if len(recipe.Allergens) == 0 {
+		return nil
+	}
+
+	for index := range recipe.Allergens {
+		recipe.Allergens[index].FoodRecipeID = recipe.ID
+	}
+
+	return tx.Create(&recipe.Allergens).Error
+}
+
+func replaceIngredients(tx *gorm.DB, recipeID uint, ingredients model.RecipeIngredients) error {
+	if err := tx.Unscoped().Where("food_recipe_id = ?", recipeID).Delete(&model.RecipeIngredient{}).Error; err != nil {
+		return err
+	}
+
+	if len(ingredients) == 0 {
+		return nil
+	}
+
+	for index := range ingredients {
+		ingredients[index].ID = 0
+		ingredients[index].FoodRecipeID = recipeID
+	}
+
+	return tx.Create(&ingredients).Error
+}
+
+func replaceSteps(tx *gorm.DB, recipeID uint, steps model.RecipeSteps) error {
+	if err := tx.Unscoped().Where("food_recipe_id = ?", recipeID).Delete(&model.RecipeStep{}).Error; err != nil {
+		return err
+	}
+
+	if len(steps) == 0 {
+		return nil
+	}
+
+	for index := range steps {
+		steps[index].ID = 0
+		steps[index].FoodRecipeID = recipeID
+	}
+
+	return tx.Create(&steps).Error
 }
 
 func (repo Repository) Delete(id string) error {
 	return repo.DB.Delete(&model.FoodRecipes{}, id).Error
 }
+
+func (repo Repository) CountTags(ids []uint) (int64, error) {
+	var count int64
+	err := repo.DB.Model(&model.Tag{}).Where("id IN ?", ids).Count(&count).Error
+	return count, err
+}
+
+func (repo Repository) GetCookingDurations() ([]model.CookingDuration, error) {
+	var durations = make([]model.CookingDuration, 0)
+	err := repo.DB.Order("id asc").Find(&durations).Error
+	return durations, err
+}
+
+func (repo Repository) GetDifficulties() ([]model.Difficulty, error) {
+	var difficulties = make([]model.Difficulty, 0)
+	err := repo.DB.Order("id asc").Find(&difficulties).Error
+	return difficulties, err
+}
@@ -120,6 +120,7 @@ func (suite *RepositoryCreateTestSuite) TestReturnRecipePointerWhenCreated() {
 		Description:       "Description",
 		Ingredient:        "Ingredient",
 		Instruction:       "Instruction",
+		Status:            model.RecipeStatusDraft,
 		CookingDurationID: 1,
 		CookingDuration: model.CookingDuration{
 			Model: gorm.Model{ID: 1},
@@ -172,11 +173,32 @@ func (suite *RepositoryGetByIDTestSuite) TestReturnRecipeByID() {
 	suite.NoError(err)
 
 	expectedRecipe := model.FoodRecipe{
-		Model:             gorm.Model{ID: 1},
-		Name:              "Omlet",
-		Description:       "Eggs fried?",
-		Ingredient:        "Eggs",
-		Instruction:       "Cooking",
+		Model:       gorm.Model{ID: 1},
+		Name:        "Omlet",
+		Description: "Eggs fried?",
+		Ingredient:  "Eggs",
+		Ingredients: model.RecipeIngredients{
+			{
+				Model:        gorm.Model{ID: 1},
+				FoodRecipeID: 1,
+				Name:         "Eggs",
+				Position:     1,
+				SearchName:   "egg",
+			},
+		},
+		Instruction: "Cooking",
+		Steps: model.RecipeSteps{
+			{
+				Model:        gorm.Model{ID: 1},
+				FoodRecipeID: 1,
+				Position:     1,
+				Text:         "Cooking",
+			},
+		},
+		Status:            model.RecipeStatusPublished,
+		RatingCount:       1,
+		AverageRating:     5,
+		RatingScore:       35.0 / 11,
 		CookingDurationID: 1,
 		CookingDuration: model.CookingDuration{
 			Model: gorm.Model{ID: 1},
@@ -190,6 +212,12 @@ func (suite *RepositoryGetByIDTestSuite) TestReturnRecipeByID() {
 	}
 
 	// Ignore CreatedAt and UpdatedAt fields
+	for index := range recipe.Ingredients {
+		recipe.Ingredients[index].CreatedAt, recipe.Ingredients[index].UpdatedAt = time.Time{}, time.Time{}
+	}
+	for index := range recipe.Steps {
+		recipe.Steps[index].CreatedAt, recipe.Steps[index].UpdatedAt = time.Time{}, time.Time{}
+	}
 	recipe.CookingDuration.CreatedAt, recipe.CookingDuration.UpdatedAt = time.Time{}, time.Time{}
 	recipe.Difficulty.CreatedAt, recipe.Difficulty.UpdatedAt = time.Time{}, time.Time{}
 	recipe.CreatedAt, recipe.UpdatedAt = time.Time{}, time.Time{}
@@ -212,6 +240,93 @@ func TestRepositoryGetByID(t *testing.T) {
 }
 
 // Extend
+type RepositoryGetTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryGetTestSuite) SetupTest() {
+	// Super
+	suite.RepositoryTestSuite.SetupTest()
+
+	err := suite.db.Create(&model.FoodRecipe{
+		Name:              "Apple pie",
+		Description:       "Dessert",
+		Ingredient:        "Apples",
+		Instruction:       "Bake",
+		Status:            model.RecipeStatusPublished,
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		SearchTitle:       "apple pie",
+		SearchBody:        "dessert apples bake",
+	}).Error
+	suite.NoError(err)
+}
+
+func (suite *RepositoryGetTestSuite) names(sort string) []string {
+	recipes, _, err := suite.repo.Get(model.FoodRecipeQuery{PageQuery: model.PageQuery{Page: 1, Limit: 10}, Sort: sort})
+	suite.NoError(err)
+
+	var names []string
+	for _, recipe := range recipes {
+		names = append(names, recipe.Name)
+	}
+	return names
+}
+
+func (suite *RepositoryGetTestSuite) TestSortByNameByDefault() {
+	suite.Equal([]string{"Apple pie", "Omlet"}, suite.names(""))
+}
+
+func (suite *RepositoryGetTestSuite) TestSortByNewest() {
+	suite.Equal([]string{"Apple pie", "Omlet"}, suite.names("newest"))
+	suite.Equal([]string{"Omlet", "Apple pie"}, suite.names("oldest"))
+}
+
+func (suite *RepositoryGetTestSuite) TestSortByRatings() {
+	suite.Equal([]string{"Omlet", "Apple pie"}, suite.names("top-rated"))
+	suite.Equal([]string{"Omlet", "Apple pie"}, suite.names("most-rated"))
+}
+
+func (suite *RepositoryGetTestSuite) TestTopRatedWeighsNumberOfRatings() {
+	err := suite.db.Exec("UPDATE food_recipes SET rating_count = 200, average_rating = 4.8 WHERE name = ?", "Apple pie").Error
+	suite.NoError(err)
+
+	// A single 5 stars does not outrank 200 ratings of 4.8
+	suite.Equal([]string{"Apple pie", "Omlet"}, suite.names("top-rated"))
+}
+
+func (suite *RepositoryGetTestSuite) TestFollowCursors() {
+	query := model.FoodRecipeQuery{PageQuery: model.PageQuery{Limit: 1}, Sort: "newest"}
+
+	first, cursors, err := suite.repo.Get(query)
+	suite.NoError(err)
+	suite.Equal("Apple pie", first[0].Name)
+	suite.Empty(cursors.Prev)
+
+	query.Cursor = cursors.Next
+	second, cursors, err := suite.repo.Get(query)
+	suite.NoError(err)
+	suite.Equal("Omlet", second[0].Name)
+	suite.Empty(cursors.Next)
+
+	query.Cursor = cursors.Prev
+	back, _, err := suite.repo.Get(query)
+	suite.NoError(err)
+	suite.Equal("Apple pie", back[0].Name)
+}
+
+func (suite *RepositoryGetTestSuite) TestSearchMatchesSegmentedText() {
+	recipes, _, err := suite.repo.Get(model.FoodRecipeQuery{PageQuery: model.PageQuery{Page: 1, Limit: 10}, Search: "Appl"})
+	suite.NoError(err)
+
+	suite.Len(recipes, 1)
+	suite.Equal("Apple pie", recipes[0].Name)
+}
+
+func TestRepositoryGet(t *testing.T) {
+	suite.Run(t, new(RepositoryGetTestSuite))
+}
+
 type RepositoryUpdateTestSuite struct {
 	RepositoryTestSuite
 	recipe model.FoodRecipe
@@ -241,7 +356,7 @@ func (suite *RepositoryUpdateTestSuite) TestUpdateRecipe() {
 		Name:  "Update Name",
 	}
 
-	err := suite.repo.Update(&suite.recipe)
+	err := suite.repo.Update(&suite.recipe, "38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
 	suite.NoError(err)
 
 	var result model.FoodRecipe
@@ -251,6 +366,42 @@ func (suite *RepositoryUpdateTestSuite) TestUpdateRecipe() {
 	suite.Equal("Update Name", result.Name)
 }
 
+func (suite *RepositoryUpdateTestSuite) TestReplaceIngredients() {
+	quantity := 2.0
+	suite.recipe.Ingredients = model.RecipeIngredients{
+		{Name: "Eggs", Quantity: &quantity, Position: 1},
+		{Name: "Fish sauce", Position: 2},
+	}
+
+	err := suite.repo.Update(&suite.recipe, "38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
+	suite.NoError(err)
+
+	var ingredients model.RecipeIngredients
+	err = suite.db.Order("position asc").Find(&ingredients, "food_recipe_id = ?", suite.recipe.ID).Error
+	suite.NoError(err)
+
+	suite.Len(ingredients, 2)
+	suite.Equal("Eggs", ingredients[0].Name)
+	suite.Equal("Fish sauce", ingredients[1].Name)
+}
+
+func (suite *RepositoryUpdateTestSuite) TestKeepRevisions() {
+	suite.recipe.Name = "Update Name"
+
+	err := suite.repo.Update(&suite.recipe, "38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
+	suite.NoError(err)
+
+	revisions, err := suite.repo.GetRevisions(suite.recipe.ID)
+	suite.NoError(err)
+
+	// The recipe was inserted directly, so its original is kept first
+	suite.Len(revisions, 2)
+	suite.Equal(2, revisions[0].Number)
+	suite.Equal("Update Name", revisions[0].Snapshot.Name)
+	suite.Equal(1, revisions[1].Number)
+	suite.Equal("Name", revisions[1].Snapshot.Name)
+}
+
 func TestRepositoryUpdate(t *testing.T) {
 	suite.Run(t, new(RepositoryUpdateTestSuite))
 }
@@ -0,0 +1,94 @@
+package foodrecipe
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/pkg/errors"
+)
+
+// DiffRevisions compares the snapshots of two revisions field by field. Lists
+// are compared item by item, so editing one ingredient only reports the
+// fields of that ingredient.
+func DiffRevisions(from, to model.RecipeRevision) (model.RevisionDiff, error) {
+	fromValue, err := snapshotValue(from.Snapshot)
+	if err != nil {
+		return model.RevisionDiff{}, errors.Wrapf(err, "read revision %d", from.Number)
+	}
+
+	toValue, err := snapshotValue(to.Snapshot)
+	if err != nil {
+		return model.RevisionDiff{}, errors.Wrapf(err, "read revision %d", to.Number)
+	}
+
+	diff := model.RevisionDiff{From: from.Number, To: to.Number}
+	diffValues("", fromValue, toValue, &diff.Changes)
+
+	return diff, nil
+}
+
+// snapshotValue decodes a snapshot into plain maps and slices, keyed by the
+// JSON names clients know.
+func snapshotValue(snapshot dto.FoodRecipeRequest) (any, error) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
+
+func diffValues(path string, from, to any, changes *[]model.FieldChange) {
+	switch fromValue := from.(type) {
+	case map[string]any:
+		if toValue, ok := to.(map[string]any); ok {
+			for _, key := range unionKeys(fromValue, toValue) {
+				field := key
+				if path != "" {
+					field = path + "." + key
+				}
+				diffValues(field, fromValue[key], toValue[key], changes)
+			}
+			return
+		}
+	case []any:
+		if toValue, ok := to.([]any); ok {
+			for index := 0; index < max(len(fromValue), len(toValue)); index++ {
+				var fromItem, toItem any
+				if index < len(fromValue) {
+					fromItem = fromValue[index]
+				}
+				if index < len(toValue) {
+					toItem = toValue[index]
+				}
+				diffValues(fmt.Sprintf("%s[%d]", path, index), fromItem, toItem, changes)
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, model.FieldChange{Field: path, From: from, To: to})
+	}
+}
+
+func unionKeys(from, to map[string]any) []string {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,49 @@
+package foodrecipe_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	quantity := 2.0
+
+	from := model.RecipeRevision{Number: 1, Snapshot: dto.FoodRecipeRequest{
+		Name:              "Omlet",
+		Ingredients:       []dto.RecipeIngredientRequest{{Name: "Eggs", Quantity: &quantity}},
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		TagIDs:            []uint{1},
+	}}
+
+	t.Run("ShouldListChangedFields", func(t *testing.T) {
+		to := from
+		to.Number = 3
+		to.Snapshot.Name = "Omelette"
+		to.Snapshot.Ingredients = []dto.RecipeIngredientRequest{
+			{Name: "Eggs", Quantity: &quantity, Unit: "ฟอง"},
+			{Name: "Fish sauce"},
+		}
+
+		diff, err := foodrecipe.DiffRevisions(from, to)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, diff.From)
+		assert.Equal(t, 3, diff.To)
+		assert.Equal(t, []model.FieldChange{
+			{Field: "ingredients[0].unit", From: "", To: "ฟอง"},
+			{Field: "ingredients[1]", From: nil, To: map[string]any{"name": "Fish sauce", "quantity": nil, "unit": "", "note": ""}},
+			{Field: "name", From: "Omlet", To: "Omelette"},
+		}, diff.Changes)
+	})
+
+	t.Run("ShouldBeEmptyWhenSame", func(t *testing.T) {
+		diff, err := foodrecipe.DiffRevisions(from, from)
+		assert.NoError(t, err)
+		assert.Empty(t, diff.Changes)
+	})
+}
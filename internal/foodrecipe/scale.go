@@ -0,0 +1,67 @@
+package foodrecipe
+
+import (
+	"math"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+)
+
+// kitchenFractions are the fractions a cook can actually measure.
+var kitchenFractions = []float64{0, 1.0 / 8, 1.0 / 4, 1.0 / 3, 1.0 / 2, 2.0 / 3, 3.0 / 4, 1}
+
+// Scale returns a copy of the recipe with every structured ingredient quantity
+// scaled from recipe.Servings to servings. The given recipe is not modified.
+func Scale(recipe model.FoodRecipe, servings int) model.FoodRecipe {
+	if servings <= 0 {
+		return recipe
+	}
+
+	base := recipe.Servings
+	if base <= 0 {
+		base = 1
+	}
+	factor := float64(servings) / float64(base)
+
+	ingredients := make(model.RecipeIngredients, len(recipe.Ingredients))
+	copy(ingredients, recipe.Ingredients)
+
+	for index, ingredient := range ingredients {
+		if ingredient.Quantity == nil {
+			continue
+		}
+
+		quantity := RoundQuantity(*ingredient.Quantity * factor)
+		ingredients[index].Quantity = &quantity
+	}
+
+	recipe.Ingredients = ingredients
+	recipe.Servings = servings
+
+	return recipe
+}
+
+// RoundQuantity rounds an amount the way it would be written in a recipe:
+// small amounts snap to the nearest ⅛, ¼, ⅓, ½, ⅔ or ¾, large amounts to a
+// whole number. Amounts below ⅛ are kept so they never round away to zero.
+func RoundQuantity(quantity float64) float64 {
+	switch {
+	case quantity <= 0:
+		return 0
+	case quantity < kitchenFractions[1]:
+		return math.Round(quantity*100) / 100
+	case quantity >= 10:
+		return math.Round(quantity)
+	}
+
+	whole, fraction := math.Modf(quantity)
+
+	nearest := kitchenFractions[0]
+	for _, candidate := range kitchenFractions[1:] {
+		if math.Abs(fraction-candidate) < math.Abs(fraction-nearest) {
+			nearest = candidate
+		}
+	}
+
+	// Three decimals is what the column stores, and it keeps ⅓ readable in JSON
+	return math.Round((whole+nearest)*1000) / 1000
+}
@@ -0,0 +1,66 @@
+package foodrecipe_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundQuantity(t *testing.T) {
+	cases := map[string]struct {
+		quantity float64
+		expected float64
+	}{
+		"ShouldKeepWholeNumber":        {quantity: 2, expected: 2},
+		"ShouldSnapToHalf":             {quantity: 1.45, expected: 1.5},
+		"ShouldSnapToQuarter":          {quantity: 0.27, expected: 0.25},
+		"ShouldSnapToThird":            {quantity: 2.0 / 3, expected: 0.667},
+		"ShouldCarryToNextWhole":       {quantity: 2.95, expected: 3},
+		"ShouldRoundLargeToWhole":      {quantity: 12.4, expected: 12},
+		"ShouldNotRoundTinyAwayToZero": {quantity: 0.04, expected: 0.04},
+		"ShouldReturnZeroForNegative":  {quantity: -1, expected: 0},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, foodrecipe.RoundQuantity(tc.quantity))
+		})
+	}
+}
+
+func TestScale(t *testing.T) {
+	quantity := 3.0
+
+	recipe := model.FoodRecipe{
+		Servings: 4,
+		Ingredients: model.RecipeIngredients{
+			{Name: "Eggs", Quantity: &quantity, Unit: "pcs"},
+			{Name: "Salt"},
+		},
+	}
+
+	t.Run("ShouldScaleQuantitiesProportionally", func(t *testing.T) {
+		scaled := foodrecipe.Scale(recipe, 2)
+
+		assert.Equal(t, 2, scaled.Servings)
+		assert.Equal(t, 1.5, *scaled.Ingredients[0].Quantity)
+		assert.Nil(t, scaled.Ingredients[1].Quantity, "Ingredients without quantity stay as they are")
+	})
+
+	t.Run("ShouldNotModifyOriginalRecipe", func(t *testing.T) {
+		foodrecipe.Scale(recipe, 8)
+
+		assert.Equal(t, 4, recipe.Servings)
+		assert.Equal(t, 3.0, *recipe.Ingredients[0].Quantity)
+	})
+
+	t.Run("ShouldTreatMissingServingsAsOne", func(t *testing.T) {
+		scaled := foodrecipe.Scale(model.FoodRecipe{
+			Ingredients: model.RecipeIngredients{{Name: "Eggs", Quantity: &quantity}},
+		}, 2)
+
+		assert.Equal(t, 6.0, *scaled.Ingredients[0].Quantity)
+	})
+}
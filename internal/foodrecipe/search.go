@@ -0,0 +1,38 @@
+package foodrecipe
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+)
+
+// IndexSearch fills the full-text search columns of a recipe. The name is
+// indexed on its own so it can rank above matches in the body.
+func IndexSearch(recipe model.FoodRecipe) model.FoodRecipe {
+	texts := []string{recipe.Description}
+
+	if len(recipe.Ingredients) > 0 {
+		ingredients := make(model.RecipeIngredients, 0, len(recipe.Ingredients))
+		for _, ingredient := range recipe.Ingredients {
+			texts = append(texts, ingredient.Name, ingredient.Note)
+
+			ingredient.SearchName = search.Name(ingredient.Name)
+			ingredients = append(ingredients, ingredient)
+		}
+		recipe.Ingredients = ingredients
+	} else {
+		texts = append(texts, recipe.Ingredient)
+	}
+
+	if len(recipe.Steps) > 0 {
+		for _, step := range recipe.Steps {
+			texts = append(texts, step.Text)
+		}
+	} else {
+		texts = append(texts, recipe.Instruction)
+	}
+
+	recipe.SearchTitle = search.Document(recipe.Name)
+	recipe.SearchBody = search.Document(texts...)
+
+	return recipe
+}
@@ -0,0 +1,39 @@
+package foodrecipe_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexSearch(t *testing.T) {
+	t.Run("ShouldSegmentNameAndBody", func(t *testing.T) {
+		recipe := foodrecipe.IndexSearch(model.FoodRecipe{
+			Name:        "ต้มยำกุ้ง",
+			Description: "Spicy soup",
+			Ingredients: model.RecipeIngredients{
+				{Name: "กุ้ง", Note: "แกะเปลือก"},
+				{Name: "ตะไคร้"},
+			},
+			Steps: model.RecipeSteps{
+				{Text: "ต้มน้ำให้เดือด"},
+			},
+		})
+
+		assert.Equal(t, "ต้ม ยำ กุ้ง", recipe.SearchTitle)
+		assert.Equal(t, "spicy soup กุ้ง แกะ เปลือก ตะไคร้ ต้ม น้ำ ให้ เดือด", recipe.SearchBody)
+	})
+
+	t.Run("ShouldFallBackToLegacyText", func(t *testing.T) {
+		recipe := foodrecipe.IndexSearch(model.FoodRecipe{
+			Name:        "Omelette",
+			Ingredient:  "2 Eggs",
+			Instruction: "Fry",
+		})
+
+		assert.Equal(t, "omelette", recipe.SearchTitle)
+		assert.Equal(t, "2 eggs fry", recipe.SearchBody)
+	})
+}
@@ -1,11 +1,13 @@
 package foodrecipe
 
 import (
-	"github.com/go-playground/validator/v10"
+	"slices"
+
 	"github.com/klins/devpool/go-day6/wongnok/internal/global"
-	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/klins/devpool/go-day6/wongnok/internal/schemaorg"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -13,12 +15,23 @@ import (
 type IService interface {
 	Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)
 	Update(request dto.FoodRecipeRequest, id string, claims model.Claims) (model.FoodRecipe, error)
-	GetByID(id string) (model.FoodRecipe, error)
+	GetByID(id string, claims model.Claims) (model.FoodRecipe, error)
+	GetScaled(id string, servings int, claims model.Claims) (model.FoodRecipe, error)
 	GetAll() ([]model.FoodRecipe, error)
-	Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error)
+	Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, pagination.Cursors, error)
 	Count() (int64, error)
+	Facets(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error)
 	Delete(id string, claims model.Claims) error
-	GetFavorites(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error)
+	GetFavorites(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)
+	Relabel() (int, error)
+	GetRevisions(id string, claims model.Claims) (model.RecipeRevisions, error)
+	GetRevision(id string, number int, claims model.Claims) (model.RecipeRevision, error)
+	DiffRevisions(id string, from int, to int, claims model.Claims) (model.RevisionDiff, error)
+	Revert(id string, number int, claims model.Claims) (model.FoodRecipe, error)
+	Publish(id string, claims model.Claims) (model.FoodRecipe, error)
+	Fork(id string, claims model.Claims) (model.FoodRecipe, error)
+	GetForks(id string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)
+	Import(document []byte, claims model.Claims) (model.FoodRecipe, error)
 }
 
 type Service struct {
@@ -32,7 +45,7 @@ func NewService(db *gorm.DB) IService {
 }
 
 func (service Service) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
-	validate := validator.New()
+	validate := newValidator()
 	if err := validate.Struct(request); err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "request invalid")
 	}
@@ -40,65 +53,99 @@ func (service Service) Create(request dto.FoodRecipeRequest, claims model.Claims
 	var recipe model.FoodRecipe
 	recipe = recipe.FromRequest(request, claims)
 
+	if err := service.checkTags(recipe.Tags); err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	if err := checkListed(recipe); err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	recipe = LabelDietary(recipe)
+	recipe = IndexSearch(recipe)
+
 	if err := service.Repository.Create(&recipe); err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "create recipe")
 	}
 
+	recipe.Nutrition = EstimateNutrition(recipe)
+
 	return recipe, nil
 }
 
-func (service Service) GetByID(id string) (model.FoodRecipe, error) {
+// GetByID returns the recipe when the caller may see it. A recipe the caller
+// may not see is reported as not found, so its existence does not leak.
+func (service Service) GetByID(id string, claims model.Claims) (model.FoodRecipe, error) {
 	recipe, err := service.Repository.GetByID(id)
 	if err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "get recipe by ID")
 	}
 
-	// Calculate the average rating for the recipe
-	recipe = helper.CalculateAverageRating(recipe)
+	if !recipe.VisibleTo(claims.ID) {
+		return model.FoodRecipe{}, errors.Wrap(gorm.ErrRecordNotFound, "get recipe by ID")
+	}
+
+	if recipe.ForkedFromID != nil {
+		if recipe.Attribution, err = service.attribution(recipe.ID, claims); err != nil {
+			return model.FoodRecipe{}, err
+		}
+	}
+
+	recipe.Nutrition = EstimateNutrition(recipe)
 
 	return recipe, nil
 }
 
+func (service Service) GetScaled(id string, servings int, claims model.Claims) (model.FoodRecipe, error) {
+	recipe, err := service.GetByID(id, claims)
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	return Scale(recipe, servings), nil
+}
+
 func (service Service) GetAll() ([]model.FoodRecipe, error) {
 	recipes, err := service.Repository.GetAll()
 	if err != nil {
 		return nil, errors.Wrap(err, "get all recipes")
 	}
 
-	// Calculate the average rating for each recipe
-	for i, recipe := range recipes {
-		recipes[i] = helper.CalculateAverageRating(recipe)
-	}
-
 	return recipes, nil
 }
 
-func (service Service) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
-	total, err := service.Repository.Count()
+func (service Service) Get(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipes, int64, pagination.Cursors, error) {
+	total, err := service.Repository.Count(foodRecipeQuery)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, pagination.Cursors{}, err
 	}
 
-	results, err := service.Repository.Get(foodRecipeQuery)
+	results, cursors, err := service.Repository.Get(foodRecipeQuery)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, pagination.Cursors{}, err
 	}
 
-	results = helper.CalculateAverageRatings(results)
-
-	return results, total, nil
+	return results, total, cursors, nil
 }
 
 func (service Service) Count() (int64, error) {
-	count, err := service.Repository.Count()
+	count, err := service.Repository.Count(model.FoodRecipeQuery{})
 	if err != nil {
 		return 0, errors.Wrap(err, "count recipes")
 	}
 	return count, nil
 }
 
+func (service Service) Facets(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	facets, err := service.Repository.Facets(foodRecipeQuery)
+	if err != nil {
+		return model.FoodRecipeFacets{}, errors.Wrap(err, "count recipe facets")
+	}
+	return facets, nil
+}
+
 func (service Service) CountFavorites(userID string) (int64, error) {
-	count, err := service.Repository.CountFavorites(userID)
+	count, err := service.Repository.CountFavorites(model.FoodRecipeQuery{}, userID)
 	if err != nil {
 		return 0, errors.Wrap(err, "count favorite recipes")
 	}
@@ -106,7 +153,7 @@ func (service Service) CountFavorites(userID string) (int64, error) {
 }
 
 func (service Service) Update(request dto.FoodRecipeRequest, id string, claims model.Claims) (model.FoodRecipe, error) {
-	validate := validator.New()
+	validate := newValidator()
 	if err := validate.Struct(request); err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "request invalid")
 	}
@@ -122,13 +169,43 @@ func (service Service) Update(request dto.FoodRecipeRequest, id string, claims m
 		return model.FoodRecipe{}, global.ErrorForbidden
 	}
 
+	stored := recipe
 	recipe = recipe.FromRequest(request, claims)
 
-	if err := service.Repository.Update(&recipe); err != nil {
+	if err := service.checkTags(recipe.Tags); err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	// Lists the client left out are kept, so labels and search come from
+	// the stored ones rather than from nothing
+	if recipe.Ingredients == nil && recipe.Ingredient == "" {
+		recipe.Ingredients, recipe.Ingredient = stored.Ingredients, stored.Ingredient
+	}
+	if recipe.Steps == nil && recipe.Instruction == "" {
+		recipe.Steps, recipe.Instruction = stored.Steps, stored.Instruction
+	}
+	// Legacy text sent on its own replaces the stored lists, which would no
+	// longer agree with it
+	if recipe.Ingredients == nil {
+		recipe.Ingredients = model.RecipeIngredients{}
+	}
+	if recipe.Steps == nil {
+		recipe.Steps = model.RecipeSteps{}
+	}
+
+	// A listed recipe stays complete, whatever the edit takes out
+	if err := checkListed(recipe); err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	recipe = LabelDietary(recipe)
+	recipe = IndexSearch(recipe)
+
+	if err := service.Repository.Update(&recipe, claims.ID); err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "update recipe")
 	}
 
-	recipe = helper.CalculateAverageRating(recipe)
+	recipe.Nutrition = EstimateNutrition(recipe)
 
 	return recipe, nil
 }
@@ -149,18 +226,244 @@ func (service Service) Delete(id string, claims model.Claims) error {
 	return service.Repository.Delete(id)
 }
 
-func (service Service) GetFavorites(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error) {
+func (service Service) GetFavorites(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error) {
 	if claims.ID == "" {
-		return nil, 0, global.ErrorForbidden
+		return nil, 0, pagination.Cursors{}, global.ErrorForbidden
+	}
+	total, err := service.Repository.CountFavorites(foodRecipeQuery, claims.ID)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, err
+	}
+	results, cursors, err := service.Repository.GetFavorites(foodRecipeQuery, claims.ID)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, err
+	}
+	return results, total, cursors, nil
+}
+
+// findOwned returns the recipe when the caller owns it. Revisions are only
+// shown to the owner, like editing is.
+func (service Service) findOwned(id string, claims model.Claims) (model.FoodRecipe, error) {
+	recipe, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "find recipe")
+	}
+
+	if recipe.UserID != claims.ID {
+		return model.FoodRecipe{}, global.ErrorForbidden
+	}
+
+	return recipe, nil
+}
+
+func (service Service) GetRevisions(id string, claims model.Claims) (model.RecipeRevisions, error) {
+	recipe, err := service.findOwned(id, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := service.Repository.GetRevisions(recipe.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get revisions")
+	}
+
+	return revisions, nil
+}
+
+func (service Service) GetRevision(id string, number int, claims model.Claims) (model.RecipeRevision, error) {
+	recipe, err := service.findOwned(id, claims)
+	if err != nil {
+		return model.RecipeRevision{}, err
 	}
-	total, err := service.Repository.CountFavorites(claims.ID)
+
+	revision, err := service.Repository.GetRevision(recipe.ID, number)
 	if err != nil {
-		return nil, 0, err
+		return model.RecipeRevision{}, errors.Wrapf(err, "get revision %d", number)
 	}
-	results, err := service.Repository.GetFavorites(foodRecipeQuery, claims.ID)
+
+	return revision, nil
+}
+
+func (service Service) DiffRevisions(id string, from int, to int, claims model.Claims) (model.RevisionDiff, error) {
+	fromRevision, err := service.GetRevision(id, from, claims)
 	if err != nil {
-		return nil, 0, err
+		return model.RevisionDiff{}, err
 	}
-	results = helper.CalculateAverageRatings(results)
-	return results, total, nil
+
+	toRevision, err := service.GetRevision(id, to, claims)
+	if err != nil {
+		return model.RevisionDiff{}, err
+	}
+
+	return DiffRevisions(fromRevision, toRevision)
+}
+
+// Revert sets the recipe back to a revision. The history is kept: the result
+// is saved as a new revision like any other update.
+func (service Service) Revert(id string, number int, claims model.Claims) (model.FoodRecipe, error) {
+	revision, err := service.GetRevision(id, number, claims)
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	return service.Update(revision.Snapshot, id, claims)
+}
+
+// Publish lists the recipe once it is complete.
+func (service Service) Publish(id string, claims model.Claims) (model.FoodRecipe, error) {
+	recipe, err := service.findOwned(id, claims)
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	if err := CheckComplete(recipe); err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	recipe.Status = model.RecipeStatusPublished
+	if err := service.Repository.UpdateStatus(&recipe); err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "publish recipe")
+	}
+
+	recipe.Nutrition = EstimateNutrition(recipe)
+
+	return recipe, nil
+}
+
+// attribution returns the fork chain of a recipe. Recipes up the chain the
+// caller may not open only credit their author.
+func (service Service) attribution(recipeID uint, claims model.Claims) (model.RecipeAttributions, error) {
+	attributions, err := service.Repository.GetAttribution(recipeID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get attribution")
+	}
+
+	for index, attribution := range attributions {
+		if !attribution.VisibleTo(claims.ID) {
+			attributions[index] = attribution.Hide()
+		}
+	}
+
+	return attributions, nil
+}
+
+// Fork copies a recipe the caller may see into a draft of their own. The copy
+// keeps the content, tags and dietary overrides, and starts without ratings,
+// favorites or history.
+func (service Service) Fork(id string, claims model.Claims) (model.FoodRecipe, error) {
+	source, err := service.GetByID(id, claims)
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	request := source.ToRequest()
+	request.Status = model.RecipeStatusDraft
+
+	var recipe model.FoodRecipe
+	recipe = recipe.FromRequest(request, claims)
+	recipe.ForkedFromID = &source.ID
+
+	recipe = LabelDietary(recipe)
+	recipe = IndexSearch(recipe)
+
+	if err := service.Repository.Create(&recipe); err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "fork recipe")
+	}
+
+	if recipe.Attribution, err = service.attribution(recipe.ID, claims); err != nil {
+		return model.FoodRecipe{}, err
+	}
+	recipe.Nutrition = EstimateNutrition(recipe)
+
+	return recipe, nil
+}
+
+// GetForks lists the published forks of a recipe the caller may see.
+func (service Service) GetForks(id string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error) {
+	recipe, err := service.Repository.GetByID(id)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "find recipe")
+	}
+
+	if !recipe.VisibleTo(claims.ID) {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+	}
+
+	total, err := service.Repository.CountForks(recipe.ID)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "count forks")
+	}
+
+	forks, cursors, err := service.Repository.GetForks(recipe.ID, query)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "get forks")
+	}
+
+	return forks, total, cursors, nil
+}
+
+// Import creates a draft of the caller from a schema.org recipe, given as a
+// JSON-LD document or an HTML page embedding one. The draft goes through the
+// same validation as a recipe written here.
+func (service Service) Import(document []byte, claims model.Claims) (model.FoodRecipe, error) {
+	source, err := schemaorg.Parse(document)
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "parse document")
+	}
+
+	durations, err := service.Repository.GetCookingDurations()
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "get cooking durations")
+	}
+
+	difficulties, err := service.Repository.GetDifficulties()
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "get difficulties")
+	}
+
+	return service.Create(ImportRequest(source, durations, difficulties), claims)
+}
+
+// Relabel derives the dietary labels and search columns of every recipe
+// again, keeping the authors' overrides. Run it after the dietary rules or
+// the search dictionary change.
+func (service Service) Relabel() (int, error) {
+	recipes, err := service.Repository.GetAll()
+	if err != nil {
+		return 0, errors.Wrap(err, "get all recipes")
+	}
+
+	for index, recipe := range recipes {
+		recipe = LabelDietary(recipe)
+		recipe = IndexSearch(recipe)
+		if err := service.Repository.UpdateDerived(&recipe); err != nil {
+			return index, errors.Wrapf(err, "relabel recipe %d", recipe.ID)
+		}
+	}
+
+	return len(recipes), nil
+}
+
+// checkTags makes sure every tag assigned to a recipe exists, so a typo in an
+// ID is a bad request rather than a foreign key failure.
+func (service Service) checkTags(tags model.Tags) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	// A tag listed twice is still one tag
+	ids := tags.IDs()
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	count, err := service.Repository.CountTags(ids)
+	if err != nil {
+		return errors.Wrap(err, "count tags")
+	}
+
+	if count != int64(len(ids)) {
+		return global.ErrorUnknownTag
+	}
+
+	return nil
 }
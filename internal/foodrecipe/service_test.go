@@ -5,9 +5,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/schemaorg"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -55,6 +58,7 @@ func (suite *ServiceCreateTestSuite) SetupTest() {
 			Instruction:       "Instruction",
 			CookingDurationID: 1,
 			DifficultyID:      1,
+			UserID:            "user-id",
 		}
 	}).Return(func(*model.FoodRecipe) error {
 		return suite.errRepositoryCreate
@@ -138,11 +142,100 @@ func (suite *ServiceCreateTestSuite) TestErrorWhenRepositoryCreate() {
 		Instruction:       request.Instruction,
 		CookingDurationID: request.CookingDurationID,
 		DifficultyID:      request.DifficultyID,
+		UserID:            claims.ID,
 	})
 	suite.repo.AssertExpectations(suite.T())
 
 }
 
+func (suite *ServiceCreateTestSuite) TestErrorWhenIngredientInvalid() {
+	claims := model.Claims{
+		ID: "user-id",
+	}
+
+	quantity := -1.0
+	recipe, err := suite.service.Create(dto.FoodRecipeRequest{
+		Name:              "Name",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		Ingredients: []dto.RecipeIngredientRequest{
+			{Name: "Eggs", Quantity: &quantity},
+		},
+	}, claims)
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenStepPositionsNotContiguous() {
+	claims := model.Claims{
+		ID: "user-id",
+	}
+
+	recipe, err := suite.service.Create(dto.FoodRecipeRequest{
+		Name:              "Name",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		Steps: []dto.RecipeStepRequest{
+			{Position: 1, Text: "Beat the eggs"},
+			{Position: 3, Text: "Fry"},
+		},
+	}, claims)
+	suite.Error(err)
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenTagUnknown() {
+	claims := model.Claims{
+		ID: "user-id",
+	}
+
+	suite.repo.On("CountTags", []uint{1, 99}).Return(int64(1), nil)
+
+	recipe, err := suite.service.Create(dto.FoodRecipeRequest{
+		Name:              "Name",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		TagIDs:            []uint{1, 99, 1},
+	}, claims)
+	suite.ErrorIs(err, global.ErrorUnknownTag)
+
+	suite.Empty(recipe)
+	suite.repo.AssertNotCalled(suite.T(), "Create")
+}
+
+func (suite *ServiceCreateTestSuite) TestAcceptTagListedTwice() {
+	suite.repo.On("CountTags", []uint{1, 4}).Return(int64(2), nil)
+	suite.repo.On("Create", mock.Anything).Return(nil)
+
+	_, err := suite.service.Create(dto.FoodRecipeRequest{
+		Name:              "Name",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		TagIDs:            []uint{4, 1, 4},
+	}, model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenUnlistedRecipeIncomplete() {
+	_, err := suite.service.Create(dto.FoodRecipeRequest{
+		Name:              "Name",
+		Status:            model.RecipeStatusUnlisted,
+		CookingDurationID: 1,
+		DifficultyID:      1,
+	}, model.Claims{ID: "user-id"})
+
+	suite.ErrorAs(err, &foodrecipe.IncompleteError{})
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
 func TestServiceCreate(t *testing.T) {
 	suite.Run(t, new(ServiceCreateTestSuite))
 }
@@ -167,7 +260,9 @@ func (suite *ServiceGetByIDTestSuite) SetupTest() {
 
 	suite.errRepositoryGetByID = nil
 	suite.responseRepositoryGetByID = model.FoodRecipe{
-		Name: "Name",
+		Name:   "Name",
+		Status: model.RecipeStatusPublished,
+		UserID: "user-id",
 	}
 
 	suite.repo.On("GetByID", mock.AnythingOfType("string")).Return(func(id string) (model.FoodRecipe, error) {
@@ -179,11 +274,13 @@ func (suite *ServiceGetByIDTestSuite) SetupTest() {
 }
 
 func (suite *ServiceGetByIDTestSuite) TestReturnRecipeWhenFound() {
-	recipe, err := suite.service.GetByID("1")
+	recipe, err := suite.service.GetByID("1", model.Claims{})
 	suite.NoError(err)
 
 	expectedRecipe := model.FoodRecipe{
-		Name: "Name",
+		Name:   "Name",
+		Status: model.RecipeStatusPublished,
+		UserID: "user-id",
 	}
 
 	suite.Equal(expectedRecipe, recipe)
@@ -191,12 +288,412 @@ func (suite *ServiceGetByIDTestSuite) TestReturnRecipeWhenFound() {
 }
 
 func (suite *ServiceGetByIDTestSuite) TestErrorWhenRecipeNotFound() {
-	recipe, err := suite.service.GetByID("2")
+	recipe, err := suite.service.GetByID("2", model.Claims{})
 	suite.ErrorIs(err, gorm.ErrRecordNotFound)
 	suite.Empty(recipe)
 	suite.repo.AssertCalled(suite.T(), "GetByID", "2")
 }
 
+func (suite *ServiceGetByIDTestSuite) TestErrorWhenDraftOfAnotherUser() {
+	suite.responseRepositoryGetByID.Status = model.RecipeStatusDraft
+
+	recipe, err := suite.service.GetByID("1", model.Claims{ID: "other-user-id"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Empty(recipe)
+}
+
+func (suite *ServiceGetByIDTestSuite) TestReturnDraftToAuthor() {
+	suite.responseRepositoryGetByID.Status = model.RecipeStatusDraft
+
+	recipe, err := suite.service.GetByID("1", model.Claims{ID: "user-id"})
+	suite.NoError(err)
+	suite.Equal("Name", recipe.Name)
+}
+
 func TestServiceGetByID(t *testing.T) {
 	suite.Run(t, new(ServiceGetByIDTestSuite))
 }
+
+type ServiceUpdateTestSuite struct {
+	suite.Suite
+
+	service foodrecipe.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceUpdateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &foodrecipe.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetByID", "1").Return(model.FoodRecipe{
+		Model:      gorm.Model{ID: 1},
+		Name:       "Pad kra pao",
+		Ingredient: "หมูสับ\nถั่วลิสงคั่ว",
+		Ingredients: model.RecipeIngredients{
+			{Name: "หมูสับ", Position: 1},
+			{Name: "ถั่วลิสงคั่ว", Position: 2},
+		},
+		Instruction: "ผัดหมูกับใบกะเพรา",
+		Steps: model.RecipeSteps{
+			{Position: 1, Text: "ผัดหมูกับใบกะเพรา"},
+		},
+		UserID: "user-id",
+	}, nil)
+	suite.repo.On("Update", mock.Anything, "user-id").Return(nil)
+}
+
+func (suite *ServiceUpdateTestSuite) TestLabelStoredIngredientsWhenOmitted() {
+	_, err := suite.service.Update(dto.FoodRecipeRequest{
+		Name:              "Pad kra pao moo",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+	}, "1", model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Update", mock.MatchedBy(func(recipe *model.FoodRecipe) bool {
+		return !recipe.Dietary.Vegetarian &&
+			!recipe.Dietary.HalalFriendly &&
+			len(recipe.Allergens) == 1 &&
+			recipe.Allergens[0].Allergen == "peanut"
+	}), "user-id")
+}
+
+func (suite *ServiceUpdateTestSuite) TestIndexStoredIngredientsAndStepsWhenOmitted() {
+	_, err := suite.service.Update(dto.FoodRecipeRequest{
+		Name:              "Pad kra pao moo",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+	}, "1", model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Update", mock.MatchedBy(func(recipe *model.FoodRecipe) bool {
+		return strings.Contains(recipe.SearchBody, "ถั่วลิสง") &&
+			strings.Contains(recipe.SearchBody, "กะเพรา")
+	}), "user-id")
+}
+
+func (suite *ServiceUpdateTestSuite) TestClearStoredIngredientsWhenOnlyTextSent() {
+	updated, err := suite.service.Update(dto.FoodRecipeRequest{
+		Name:              "Pad kra pao moo",
+		Ingredient:        "หมูสับ\nใบกะเพรา",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+	}, "1", model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.Equal("หมูสับ\nใบกะเพรา", updated.Ingredient)
+	suite.repo.AssertCalled(suite.T(), "Update", mock.MatchedBy(func(recipe *model.FoodRecipe) bool {
+		return recipe.Ingredients != nil && len(recipe.Ingredients) == 0
+	}), "user-id")
+}
+
+func (suite *ServiceUpdateTestSuite) TestClearStoredStepsWhenOnlyInstructionSent() {
+	updated, err := suite.service.Update(dto.FoodRecipeRequest{
+		Name:              "Pad kra pao moo",
+		Instruction:       "ผัดหมูสับ\nใส่ใบกะเพรา",
+		CookingDurationID: 1,
+		DifficultyID:      1,
+	}, "1", model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.Equal("ผัดหมูสับ\nใส่ใบกะเพรา", updated.Instruction)
+	suite.repo.AssertCalled(suite.T(), "Update", mock.MatchedBy(func(recipe *model.FoodRecipe) bool {
+		return recipe.Steps != nil && len(recipe.Steps) == 0
+	}), "user-id")
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenListedRecipeLeftIncomplete() {
+	suite.repo.On("GetByID", "2").Return(model.FoodRecipe{
+		Model:       gorm.Model{ID: 2},
+		Name:        "Omelette",
+		Description: "Fluffy",
+		Ingredient:  "Eggs",
+		Instruction: "Fry",
+		Status:      model.RecipeStatusPublished,
+		UserID:      "user-id",
+	}, nil)
+
+	_, err := suite.service.Update(dto.FoodRecipeRequest{
+		Name:              "Omelette",
+		Ingredients:       []dto.RecipeIngredientRequest{},
+		Steps:             []dto.RecipeStepRequest{},
+		CookingDurationID: 1,
+		DifficultyID:      1,
+	}, "2", model.Claims{ID: "user-id"})
+
+	var incomplete foodrecipe.IncompleteError
+	suite.ErrorAs(err, &incomplete)
+	suite.Equal([]string{"description", "ingredients", "steps"}, incomplete.Missing)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+type ServiceRevertTestSuite struct {
+	suite.Suite
+
+	service foodrecipe.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceRevertTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &foodrecipe.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetByID", "1").Return(model.FoodRecipe{
+		Model:  gorm.Model{ID: 1},
+		Name:   "Omelette",
+		UserID: "user-id",
+	}, nil)
+	suite.repo.On("GetRevision", uint(1), 1).Return(model.RecipeRevision{
+		FoodRecipeID: 1,
+		Number:       1,
+		Snapshot: dto.FoodRecipeRequest{
+			Name:              "Omlet",
+			CookingDurationID: 1,
+			DifficultyID:      1,
+		},
+	}, nil)
+	suite.repo.On("Update", mock.Anything, "user-id").Return(nil)
+}
+
+func (suite *ServiceRevertTestSuite) TestUpdateWithRevisionSnapshot() {
+	recipe, err := suite.service.Revert("1", 1, model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.Equal("Omlet", recipe.Name)
+	suite.repo.AssertCalled(suite.T(), "Update", mock.MatchedBy(func(recipe *model.FoodRecipe) bool {
+		return recipe.ID == 1 && recipe.Name == "Omlet"
+	}), "user-id")
+}
+
+func (suite *ServiceRevertTestSuite) TestErrorWhenNotOwner() {
+	_, err := suite.service.Revert("1", 1, model.Claims{ID: "other-user-id"})
+
+	suite.ErrorIs(err, global.ErrorForbidden)
+	suite.repo.AssertNotCalled(suite.T(), "GetRevision", mock.Anything, mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func TestServiceRevert(t *testing.T) {
+	suite.Run(t, new(ServiceRevertTestSuite))
+}
+
+type ServicePublishTestSuite struct {
+	suite.Suite
+
+	service foodrecipe.IService
+	repo    *MockIRepository
+	recipe  model.FoodRecipe
+}
+
+func (suite *ServicePublishTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &foodrecipe.Service{
+		Repository: suite.repo,
+	}
+
+	suite.recipe = model.FoodRecipe{
+		Model:       gorm.Model{ID: 1},
+		Name:        "Omlet",
+		Description: "Eggs fried",
+		Ingredients: model.RecipeIngredients{{Name: "Eggs", Position: 1}},
+		Steps:       model.RecipeSteps{{Text: "Fry", Position: 1}},
+		Status:      model.RecipeStatusDraft,
+		UserID:      "user-id",
+	}
+
+	suite.repo.On("GetByID", "1").Return(func(string) (model.FoodRecipe, error) {
+		return suite.recipe, nil
+	})
+	suite.repo.On("UpdateStatus", mock.Anything).Return(nil)
+}
+
+func (suite *ServicePublishTestSuite) TestPublishCompleteRecipe() {
+	recipe, err := suite.service.Publish("1", model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.Equal(model.RecipeStatusPublished, recipe.Status)
+	suite.repo.AssertCalled(suite.T(), "UpdateStatus", mock.MatchedBy(func(recipe *model.FoodRecipe) bool {
+		return recipe.Status == model.RecipeStatusPublished
+	}))
+}
+
+func (suite *ServicePublishTestSuite) TestErrorWhenIncomplete() {
+	suite.recipe.Description = ""
+	suite.recipe.Steps = nil
+
+	_, err := suite.service.Publish("1", model.Claims{ID: "user-id"})
+
+	var incomplete foodrecipe.IncompleteError
+	suite.ErrorAs(err, &incomplete)
+	suite.Equal([]string{"description", "steps"}, incomplete.Missing)
+	suite.repo.AssertNotCalled(suite.T(), "UpdateStatus", mock.Anything)
+}
+
+func (suite *ServicePublishTestSuite) TestErrorWhenNotOwner() {
+	_, err := suite.service.Publish("1", model.Claims{ID: "other-user-id"})
+
+	suite.ErrorIs(err, global.ErrorForbidden)
+	suite.repo.AssertNotCalled(suite.T(), "UpdateStatus", mock.Anything)
+}
+
+func TestServicePublish(t *testing.T) {
+	suite.Run(t, new(ServicePublishTestSuite))
+}
+
+type ServiceForkTestSuite struct {
+	suite.Suite
+
+	service foodrecipe.IService
+	repo    *MockIRepository
+	source  model.FoodRecipe
+}
+
+func (suite *ServiceForkTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &foodrecipe.Service{
+		Repository: suite.repo,
+	}
+
+	quantity := 2.0
+	suite.source = model.FoodRecipe{
+		Model:             gorm.Model{ID: 1},
+		Name:              "Omlet",
+		Description:       "Eggs fried",
+		Ingredient:        "2 Eggs",
+		Ingredients:       model.RecipeIngredients{{Name: "Eggs", Quantity: &quantity, Position: 1}},
+		Instruction:       "Fry",
+		Steps:             model.RecipeSteps{{Text: "Fry", Position: 1}},
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		Status:            model.RecipeStatusPublished,
+		RatingCount:       1,
+		AverageRating:     5,
+		UserID:            "author-id",
+	}
+
+	suite.repo.On("GetByID", "1").Return(func(string) (model.FoodRecipe, error) {
+		return suite.source, nil
+	})
+	suite.repo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*model.FoodRecipe).ID = 2
+	}).Return(nil)
+	suite.repo.On("GetAttribution", uint(2)).Return(model.RecipeAttributions{
+		{FoodRecipeID: 1, Name: "Omlet", Status: model.RecipeStatusPublished, UserID: "author-id"},
+	}, nil)
+}
+
+func (suite *ServiceForkTestSuite) TestCopyIntoDraftOfCaller() {
+	recipe, err := suite.service.Fork("1", model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.Equal(uint(2), recipe.ID)
+	suite.Equal(model.RecipeAttributions{
+		{FoodRecipeID: 1, Name: "Omlet", Status: model.RecipeStatusPublished, UserID: "author-id"},
+	}, recipe.Attribution)
+
+	suite.repo.AssertCalled(suite.T(), "Create", mock.MatchedBy(func(fork *model.FoodRecipe) bool {
+		return fork.UserID == "user-id" &&
+			*fork.ForkedFromID == 1 &&
+			fork.Status == model.RecipeStatusDraft &&
+			fork.Name == "Omlet" &&
+			len(fork.Ingredients) == 1 && len(fork.Steps) == 1 &&
+			fork.RatingCount == 0 && fork.AverageRating == 0
+	}))
+}
+
+func (suite *ServiceForkTestSuite) TestErrorWhenSourcePrivate() {
+	suite.source.Status = model.RecipeStatusPrivate
+
+	_, err := suite.service.Fork("1", model.Claims{ID: "user-id"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceForkTestSuite) TestHideRecipesCallerMayNotOpen() {
+	suite.repo.ExpectedCalls = nil
+	suite.repo.On("GetByID", "2").Return(model.FoodRecipe{
+		Model:        gorm.Model{ID: 2},
+		Status:       model.RecipeStatusPublished,
+		ForkedFromID: &suite.source.ID,
+	}, nil)
+	suite.repo.On("GetAttribution", uint(2)).Return(model.RecipeAttributions{
+		{FoodRecipeID: 1, Name: "Secret", Status: model.RecipeStatusPrivate, UserID: "author-id", FirstName: "Author"},
+	}, nil)
+
+	recipe, err := suite.service.GetByID("2", model.Claims{})
+	suite.NoError(err)
+
+	suite.Equal(model.RecipeAttributions{{UserID: "author-id", FirstName: "Author"}}, recipe.Attribution)
+}
+
+func TestServiceFork(t *testing.T) {
+	suite.Run(t, new(ServiceForkTestSuite))
+}
+
+type ServiceImportTestSuite struct {
+	suite.Suite
+
+	service foodrecipe.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceImportTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &foodrecipe.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetCookingDurations").Return([]model.CookingDuration{
+		{Model: gorm.Model{ID: 1}, Name: "5 - 10"},
+		{Model: gorm.Model{ID: 2}, Name: "11 - 30"},
+	}, nil)
+	suite.repo.On("GetDifficulties").Return([]model.Difficulty{{Model: gorm.Model{ID: 1}, Name: "Easy"}}, nil)
+	suite.repo.On("Create", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceImportTestSuite) TestCreateDraftOfCaller() {
+	document := `<html><head><script type="application/ld+json">
+		{"@context": "https://schema.org", "@type": "Recipe", "name": "Khao Pad",
+		 "recipeIngredient": ["2 cups cooked rice", "1 egg"],
+		 "recipeInstructions": [{"@type": "HowToStep", "text": "Fry the rice."}],
+		 "totalTime": "PT15M"}
+	</script></head></html>`
+
+	recipe, err := suite.service.Import([]byte(document), model.Claims{ID: "user-id"})
+	suite.NoError(err)
+
+	suite.Equal("Khao Pad", recipe.Name)
+	suite.Equal(model.RecipeStatusDraft, recipe.Status)
+	suite.Equal("user-id", recipe.UserID)
+	suite.Equal(uint(2), recipe.CookingDurationID)
+	suite.Len(recipe.Ingredients, 2)
+	suite.Len(recipe.Steps, 1)
+	suite.repo.AssertCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceImportTestSuite) TestErrorWhenNoRecipe() {
+	_, err := suite.service.Import([]byte(`{"@type": "Article"}`), model.Claims{ID: "user-id"})
+	suite.ErrorIs(err, schemaorg.ErrNoRecipe)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceImportTestSuite) TestErrorWhenRecipeHasNoName() {
+	_, err := suite.service.Import([]byte(`{"@type": "Recipe", "recipeIngredient": ["1 egg"]}`), model.Claims{ID: "user-id"})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func TestServiceImport(t *testing.T) {
+	suite.Run(t, new(ServiceImportTestSuite))
+}
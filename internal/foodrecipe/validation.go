@@ -0,0 +1,29 @@
+package foodrecipe
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+)
+
+// newValidator returns a validator that also knows the recipe rules which
+// cannot be written as struct tags. Errors still come back as
+// validator.ValidationErrors so handlers can answer 400.
+func newValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterStructValidation(validateSteps, dto.FoodRecipeRequest{})
+	return validate
+}
+
+// validateSteps requires step positions to be exactly 1..n, in any order.
+func validateSteps(sl validator.StructLevel) {
+	request := sl.Current().Interface().(dto.FoodRecipeRequest)
+
+	seen := make(map[int]bool, len(request.Steps))
+	for _, step := range request.Steps {
+		if step.Position < 1 || step.Position > len(request.Steps) || seen[step.Position] {
+			sl.ReportError(request.Steps, "Steps", "Steps", "contiguous", "")
+			return
+		}
+		seen[step.Position] = true
+	}
+}
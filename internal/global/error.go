@@ -6,4 +6,11 @@ var (
 	ErrorForbidden      = errors.New("forbidden")
 	ErrorNotFound       = errors.New("not found")
 	ErrorInternalServer = errors.New("internal server error")
+	ErrorUnknownTag     = errors.New("unknown tag")
+	ErrorInvalidCursor  = errors.New("invalid cursor")
+	ErrorInvalidOrder   = errors.New("order must list every recipe once")
+	ErrorInvalidRange   = errors.New("invalid date range")
+	ErrorOwnRecipe      = errors.New("authors cannot rate their own recipes")
+	ErrorTooLarge       = errors.New("file is too large")
+	ErrorNameTaken      = errors.New("name is already in use")
 )
@@ -0,0 +1,35 @@
+package helper
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+)
+
+// FormOverhead is room for the multipart boundaries and part headers around
+// the file of a form.
+const FormOverhead = 64 << 10
+
+// FormFile returns the named file of a multipart form, or global.ErrorTooLarge
+// when it is over limit. The body is cut off while it is read, so a large
+// upload is refused before it is spooled to disk.
+func FormFile(ctx *gin.Context, name string, limit int64) (*multipart.FileHeader, error) {
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, limit+FormOverhead)
+
+	header, err := ctx.FormFile(name)
+	if tooLarge := new(http.MaxBytesError); errors.As(err, &tooLarge) {
+		return nil, global.ErrorTooLarge
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Size > limit {
+		return nil, global.ErrorTooLarge
+	}
+
+	return header, nil
+}
@@ -0,0 +1,140 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+// exifOrientation reads the orientation a camera stored in the EXIF block of
+// a JPEG, 1 (upright) when there is none. Only the segments before the image
+// data are read.
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	offset := 2
+	for offset+4 <= len(data) {
+		if data[offset] != 0xFF {
+			return 1
+		}
+
+		marker := data[offset+1]
+		switch {
+		case marker == 0xFF:
+			// Markers may be padded with fill bytes
+			offset++
+			continue
+		case marker == 0xDA || marker == 0xD9:
+			// The image data starts, or the file ends, without EXIF
+			return 1
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			// Markers without a length
+			offset += 2
+			continue
+		}
+
+		length := int(binary.BigEndian.Uint16(data[offset+2:]))
+		if length < 2 || offset+2+length > len(data) {
+			return 1
+		}
+
+		segment := data[offset+4 : offset+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+
+		offset += 2 + length
+	}
+
+	return 1
+}
+
+// tiffOrientation finds the orientation tag in the first directory of the
+// TIFF structure EXIF is written in.
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	if order.Uint16(tiff[2:]) != 42 {
+		return 1
+	}
+
+	directory := int(order.Uint32(tiff[4:]))
+	if directory < 8 || directory+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[directory:]))
+	for index := 0; index < count; index++ {
+		entry := directory + 2 + index*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+
+		// The orientation is a SHORT, kept in the first bytes of the value
+		if order.Uint16(tiff[entry:]) == 0x0112 && order.Uint16(tiff[entry+2:]) == 3 {
+			if value := int(order.Uint16(tiff[entry+8:])); value >= 1 && value <= 8 {
+				return value
+			}
+			return 1
+		}
+	}
+
+	return 1
+}
+
+// orient turns the picture upright for its EXIF orientation: 2 to 4 mirror
+// or turn it half way round, 5 to 8 turn it a quarter, mirrored or not.
+func orient(picture *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return picture
+	}
+
+	width, height := picture.Bounds().Dx(), picture.Bounds().Dy()
+
+	// source tells which pixel of the picture ends up at x, y
+	var source func(x, y int) (int, int)
+	switch orientation {
+	case 2:
+		source = func(x, y int) (int, int) { return width - 1 - x, y }
+	case 3:
+		source = func(x, y int) (int, int) { return width - 1 - x, height - 1 - y }
+	case 4:
+		source = func(x, y int) (int, int) { return x, height - 1 - y }
+	case 5:
+		source = func(x, y int) (int, int) { return y, x }
+	case 6:
+		source = func(x, y int) (int, int) { return y, height - 1 - x }
+	case 7:
+		source = func(x, y int) (int, int) { return width - 1 - y, height - 1 - x }
+	case 8:
+		source = func(x, y int) (int, int) { return width - 1 - y, x }
+	}
+
+	bounds := image.Rect(0, 0, width, height)
+	if orientation >= 5 {
+		bounds = image.Rect(0, 0, height, width)
+	}
+
+	result := image.NewRGBA(bounds)
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			sx, sy := source(x, y)
+			copy(result.Pix[result.PixOffset(x, y):][:4], picture.Pix[picture.PixOffset(sx, sy):][:4])
+		}
+	}
+
+	return result
+}
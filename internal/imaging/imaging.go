@@ -0,0 +1,146 @@
+// Package imaging turns an uploaded picture into the images the site shows:
+// the picture itself, at most MaxSide pixels on a side, and the thumbnails of
+// model.ImageSizes.
+//
+// Every image is decoded and encoded again, which drops the EXIF block and
+// any other metadata, GPS position included. The EXIF orientation is applied
+// first, so photos taken on their side still show upright.
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnsupportedType = errors.New("unsupported image type")
+	ErrInvalidImage    = errors.New("invalid image")
+	ErrTooManyPixels   = errors.New("image has too many pixels")
+)
+
+const (
+	// MaxSide is the longest side of the picture kept as the original
+	MaxSide = 2048
+	// MaxPixels keeps a small file that decodes into a huge picture out
+	MaxPixels = 40_000_000
+	// Quality is the JPEG quality of every image written
+	Quality = 85
+)
+
+// contentTypes are the types an upload may be, as sniffed from its content.
+var contentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// Variant is one image written from an upload.
+type Variant struct {
+	Name   string // "original" or the name of an image size
+	Data   []byte
+	Width  int
+	Height int
+}
+
+// Image is an upload ready to store. Images with transparency are kept as
+// PNG, all others become JPEG.
+type Image struct {
+	ContentType string
+	Extension   string
+	Variants    []Variant
+}
+
+// Sniff tells the type of an upload from its first bytes, ignoring what the
+// client claims it is.
+func Sniff(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if !contentTypes[contentType] {
+		return "", errors.Wrap(ErrUnsupportedType, contentType)
+	}
+	return contentType, nil
+}
+
+// Process checks the upload, then writes the original and every thumbnail.
+func Process(data []byte) (Image, error) {
+	contentType, err := Sniff(data)
+	if err != nil {
+		return Image{}, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, errors.Wrap(ErrInvalidImage, err.Error())
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return Image{}, errors.Wrap(ErrInvalidImage, "empty image")
+	}
+	if config.Width*config.Height > MaxPixels {
+		return Image{}, errors.Wrapf(ErrTooManyPixels, "%dx%d", config.Width, config.Height)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, errors.Wrap(ErrInvalidImage, err.Error())
+	}
+
+	picture := toRGBA(decoded)
+	if contentType == "image/jpeg" {
+		picture = orient(picture, exifOrientation(data))
+	}
+
+	result := Image{ContentType: "image/jpeg", Extension: "jpg"}
+	if !picture.Opaque() {
+		result.ContentType, result.Extension = "image/png", "png"
+	}
+
+	// Each size is scaled down from the one above it, which is quicker than
+	// starting over from the original every time
+	sizes := []model.ImageSize{{Name: "original", Pixels: MaxSide}}
+	for index := len(model.ImageSizes) - 1; index >= 0; index-- {
+		sizes = append(sizes, model.ImageSizes[index])
+	}
+
+	for _, size := range sizes {
+		picture = Fit(picture, size.Pixels)
+
+		encoded, err := encode(picture, result.Extension)
+		if err != nil {
+			return Image{}, errors.Wrapf(err, "encode %s", size.Name)
+		}
+
+		result.Variants = append(result.Variants, Variant{
+			Name:   size.Name,
+			Data:   encoded,
+			Width:  picture.Bounds().Dx(),
+			Height: picture.Bounds().Dy(),
+		})
+	}
+
+	return result, nil
+}
+
+func toRGBA(picture image.Image) *image.RGBA {
+	bounds := picture.Bounds()
+	result := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(result, result.Bounds(), picture, bounds.Min, draw.Src)
+	return result
+}
+
+func encode(picture image.Image, extension string) ([]byte, error) {
+	var buffer bytes.Buffer
+	var err error
+	if extension == "png" {
+		err = png.Encode(&buffer, picture)
+	} else {
+		err = jpeg.Encode(&buffer, picture, &jpeg.Options{Quality: Quality})
+	}
+	return buffer.Bytes(), err
+}
@@ -0,0 +1,168 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// photo is a JPEG 300 wide and 200 high, red on its left half and blue on
+// its right, as a camera held on its side would store it.
+func photo(t *testing.T) []byte {
+	picture := image.NewRGBA(image.Rect(0, 0, 300, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 300; x++ {
+			if x < 150 {
+				picture.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				picture.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buffer, picture, &jpeg.Options{Quality: 90}))
+	return buffer.Bytes()
+}
+
+// withOrientation adds an EXIF block holding the orientation, and a camera
+// model to show the block is dropped, right after the start of the JPEG.
+func withOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = append(tiff, 0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+	tiff = append(tiff, "Camera"...)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	return append(append(append([]byte{}, data[:2]...), app1...), data[2:]...)
+}
+
+// pngHeader is the start of a PNG claiming the size, enough for its size to
+// be read without the pixels.
+func pngHeader(width uint32, height uint32) []byte {
+	chunk := []byte("IHDR")
+	chunk = binary.BigEndian.AppendUint32(chunk, width)
+	chunk = binary.BigEndian.AppendUint32(chunk, height)
+	chunk = append(chunk, 8, 2, 0, 0, 0)
+
+	data := []byte("\x89PNG\r\n\x1a\n")
+	data = binary.BigEndian.AppendUint32(data, 13)
+	data = append(data, chunk...)
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(chunk))
+}
+
+func TestProcess(t *testing.T) {
+	t.Run("ShouldWriteOriginalAndThumbnails", func(t *testing.T) {
+		result, err := imaging.Process(photo(t))
+		require.NoError(t, err)
+
+		assert.Equal(t, "image/jpeg", result.ContentType)
+		assert.Equal(t, "jpg", result.Extension)
+
+		require.Len(t, result.Variants, 4)
+		sizes := map[string][2]int{}
+		for _, variant := range result.Variants {
+			sizes[variant.Name] = [2]int{variant.Width, variant.Height}
+
+			decoded, err := jpeg.Decode(bytes.NewReader(variant.Data))
+			require.NoError(t, err)
+			assert.Equal(t, variant.Width, decoded.Bounds().Dx())
+		}
+
+		assert.Equal(t, map[string][2]int{
+			"original": {300, 200},
+			"large":    {300, 200},
+			"medium":   {300, 200},
+			"small":    {160, 107},
+		}, sizes)
+	})
+
+	t.Run("ShouldTurnUprightAndDropEXIF", func(t *testing.T) {
+		result, err := imaging.Process(withOrientation(photo(t), 6))
+		require.NoError(t, err)
+
+		original := result.Variants[0]
+		assert.Equal(t, [2]int{200, 300}, [2]int{original.Width, original.Height})
+		assert.NotContains(t, string(original.Data), "Exif")
+		assert.NotContains(t, string(original.Data), "Camera")
+
+		// Turned a quarter clockwise, the red half of the photo is on top
+		decoded, err := jpeg.Decode(bytes.NewReader(original.Data))
+		require.NoError(t, err)
+		r, _, b, _ := decoded.At(100, 50).RGBA()
+		assert.Greater(t, r, b)
+		r, _, b, _ = decoded.At(100, 250).RGBA()
+		assert.Greater(t, b, r)
+	})
+
+	t.Run("ShouldKeepTransparencyAsPNG", func(t *testing.T) {
+		picture := image.NewNRGBA(image.Rect(0, 0, 20, 20))
+		picture.Set(5, 5, color.NRGBA{G: 255, A: 255})
+
+		var buffer bytes.Buffer
+		require.NoError(t, png.Encode(&buffer, picture))
+
+		result, err := imaging.Process(buffer.Bytes())
+		require.NoError(t, err)
+
+		assert.Equal(t, "image/png", result.ContentType)
+		assert.Equal(t, "png", result.Extension)
+	})
+
+	t.Run("ShouldRejectWhatIsNotAnImage", func(t *testing.T) {
+		_, err := imaging.Process([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+		assert.ErrorIs(t, err, imaging.ErrUnsupportedType)
+
+		_, err = imaging.Process([]byte("RIFF\x00\x00\x00\x00WEBPVP8 "))
+		assert.ErrorIs(t, err, imaging.ErrUnsupportedType)
+	})
+
+	t.Run("ShouldRejectBrokenImage", func(t *testing.T) {
+		_, err := imaging.Process(photo(t)[:200])
+		assert.ErrorIs(t, err, imaging.ErrInvalidImage)
+	})
+
+	t.Run("ShouldRejectImageWithTooManyPixels", func(t *testing.T) {
+		_, err := imaging.Process(pngHeader(10000, 10000))
+		assert.ErrorIs(t, err, imaging.ErrTooManyPixels)
+	})
+}
+
+func TestFit(t *testing.T) {
+	t.Run("ShouldKeepProportions", func(t *testing.T) {
+		fitted := imaging.Fit(image.NewRGBA(image.Rect(0, 0, 4000, 1000)), 2048)
+		assert.Equal(t, image.Rect(0, 0, 2048, 512), fitted.Bounds())
+
+		fitted = imaging.Fit(image.NewRGBA(image.Rect(0, 0, 10, 1000)), 160)
+		assert.Equal(t, image.Rect(0, 0, 2, 160), fitted.Bounds())
+	})
+
+	t.Run("ShouldAverageColours", func(t *testing.T) {
+		picture := image.NewRGBA(image.Rect(0, 0, 4, 2))
+		for x := 0; x < 4; x += 2 {
+			picture.Set(x, 0, color.RGBA{R: 200, A: 255})
+			picture.Set(x, 1, color.RGBA{R: 100, A: 255})
+			picture.Set(x+1, 0, color.RGBA{A: 255})
+			picture.Set(x+1, 1, color.RGBA{A: 255})
+		}
+
+		fitted := imaging.Fit(picture, 2)
+		assert.Equal(t, image.Rect(0, 0, 2, 1), fitted.Bounds())
+		assert.Equal(t, color.RGBA{R: 75, A: 255}, fitted.At(0, 0))
+	})
+}
@@ -0,0 +1,64 @@
+package imaging
+
+import (
+	"image"
+)
+
+// Fit scales the picture down to fit a square of side pixels, keeping its
+// proportions. Pictures already small enough are returned as they are; they
+// are never scaled up.
+func Fit(picture *image.RGBA, side int) *image.RGBA {
+	width, height := picture.Bounds().Dx(), picture.Bounds().Dy()
+	if width <= side && height <= side {
+		return picture
+	}
+
+	if width >= height {
+		height = max(1, (height*side+width/2)/width)
+		width = side
+	} else {
+		width = max(1, (width*side+height/2)/height)
+		height = side
+	}
+
+	return resize(picture, width, height)
+}
+
+// resize scales the picture down by averaging the source pixels each target
+// pixel covers. Averaging premultiplied colours keeps transparent pixels
+// from darkening the edges around them.
+func resize(picture *image.RGBA, width int, height int) *image.RGBA {
+	source := picture.Bounds()
+	result := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := y * source.Dy() / height
+		y1 := max(y0+1, (y+1)*source.Dy()/height)
+
+		for x := 0; x < width; x++ {
+			x0 := x * source.Dx() / width
+			x1 := max(x0+1, (x+1)*source.Dx()/width)
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := picture.PixOffset(source.Min.X+x0, source.Min.Y+sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(picture.Pix[offset])
+					g += uint64(picture.Pix[offset+1])
+					b += uint64(picture.Pix[offset+2])
+					a += uint64(picture.Pix[offset+3])
+					offset += 4
+					count++
+				}
+			}
+
+			offset := result.PixOffset(x, y)
+			result.Pix[offset] = uint8((r + count/2) / count)
+			result.Pix[offset+1] = uint8((g + count/2) / count)
+			result.Pix[offset+2] = uint8((b + count/2) / count)
+			result.Pix[offset+3] = uint8((a + count/2) / count)
+		}
+	}
+
+	return result
+}
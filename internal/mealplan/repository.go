@@ -0,0 +1,75 @@
+package mealplan
+
+import (
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	Get(userID string, from time.Time, to time.Time) (model.MealPlanEntries, error)
+	GetByID(id string) (model.MealPlanEntry, error)
+	Create(entry *model.MealPlanEntry) error
+	CreateMany(entries model.MealPlanEntries) error
+	Delete(id uint) error
+	DeleteDay(userID string, date time.Time) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// sameMeal is the key an entry is unique by: a recipe is planned once per
+// meal of a day.
+var sameMeal = []clause.Column{{Name: "user_id"}, {Name: "date"}, {Name: "slot"}, {Name: "food_recipe_id"}}
+
+// Get returns the entries of a user from one date to another, both inclusive.
+// Dates are compared as text so the session time zone cannot shift them.
+func (repo Repository) Get(userID string, from time.Time, to time.Time) (model.MealPlanEntries, error) {
+	var entries = make(model.MealPlanEntries, 0)
+	err := repo.DB.Where("user_id = ? AND date BETWEEN ? AND ?", userID, from.Format(model.DateLayout), to.Format(model.DateLayout)).
+		Order("date asc, id asc").
+		Find(&entries).Error
+	return entries, err
+}
+
+func (repo Repository) GetByID(id string) (model.MealPlanEntry, error) {
+	var entry model.MealPlanEntry
+	err := repo.DB.First(&entry, "id = ?", id).Error
+	return entry, err
+}
+
+// Create adds an entry, or changes the servings of the same recipe already
+// planned for that meal.
+func (repo Repository) Create(entry *model.MealPlanEntry) error {
+	return repo.DB.Clauses(clause.OnConflict{
+		Columns:   sameMeal,
+		DoUpdates: clause.AssignmentColumns([]string{"servings", "updated_at"}),
+	}).Create(entry).Error
+}
+
+// CreateMany adds entries, leaving the meals that already have the recipe as
+// they are.
+func (repo Repository) CreateMany(entries model.MealPlanEntries) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return repo.DB.Clauses(clause.OnConflict{Columns: sameMeal, DoNothing: true}).Create(&entries).Error
+}
+
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Delete(&model.MealPlanEntry{}, id).Error
+}
+
+func (repo Repository) DeleteDay(userID string, date time.Time) error {
+	return repo.DB.Where("user_id = ? AND date = ?", userID, date.Format(model.DateLayout)).Delete(&model.MealPlanEntry{}).Error
+}
@@ -0,0 +1,212 @@
+package mealplan
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.MealPlanQuery, claims model.Claims) (model.MealPlan, error)
+	AddEntry(request dto.MealPlanEntryRequest, claims model.Claims) (model.MealPlanEntry, error)
+	DeleteEntry(id string, claims model.Claims) error
+	CopyWeek(request dto.MealPlanCopyRequest, claims model.Claims) (model.MealPlan, error)
+	ClearDay(date string, claims model.Claims) error
+}
+
+type Service struct {
+	Repository IRepository
+	Recipes    foodrecipe.IRepository
+	Now        func() time.Time
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+		Recipes:    foodrecipe.NewRepository(db),
+		Now:        time.Now,
+	}
+}
+
+// withRecipes fills the recipe of each entry, scaled to its servings. Entries
+// of recipes that were deleted, or that the user may no longer open, are left
+// out rather than shown empty.
+func (service Service) withRecipes(entries model.MealPlanEntries, userID string) (model.MealPlanEntries, error) {
+	ids := make([]uint, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.FoodRecipeID)
+	}
+
+	recipes, err := service.Recipes.GetByIDs(ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "get recipes")
+	}
+
+	byID := make(map[uint]model.FoodRecipe, len(recipes))
+	for _, recipe := range recipes {
+		byID[recipe.ID] = recipe
+	}
+
+	results := make(model.MealPlanEntries, 0, len(entries))
+	for _, entry := range entries {
+		recipe, ok := byID[entry.FoodRecipeID]
+		if !ok || !recipe.VisibleTo(userID) {
+			continue
+		}
+
+		entry.FoodRecipe = recipe
+		if entry.Servings != nil {
+			entry.FoodRecipe = foodrecipe.Scale(recipe, *entry.Servings)
+		}
+		results = append(results, entry)
+	}
+
+	return results, nil
+}
+
+func (service Service) getPlan(userID string, from time.Time, to time.Time) (model.MealPlan, error) {
+	entries, err := service.Repository.Get(userID, from, to)
+	if err != nil {
+		return model.MealPlan{}, errors.Wrap(err, "get meal plan")
+	}
+
+	entries, err = service.withRecipes(entries, userID)
+	if err != nil {
+		return model.MealPlan{}, err
+	}
+
+	return model.MealPlan{From: from, To: to, Entries: entries}, nil
+}
+
+func (service Service) Get(query model.MealPlanQuery, claims model.Claims) (model.MealPlan, error) {
+	from, to, err := Dates(query, service.Now())
+	if err != nil {
+		return model.MealPlan{}, err
+	}
+
+	return service.getPlan(claims.ID, from, to)
+}
+
+// AddEntry plans a recipe the user may open for a meal. Planning the same
+// recipe for the same meal again only changes its servings.
+func (service Service) AddEntry(request dto.MealPlanEntryRequest, claims model.Claims) (model.MealPlanEntry, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "request invalid")
+	}
+
+	date, err := ParseDate(request.Date)
+	if err != nil {
+		return model.MealPlanEntry{}, err
+	}
+
+	recipe, err := service.Recipes.GetByID(strconv.FormatUint(uint64(request.RecipeID), 10))
+	if err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "find recipe")
+	}
+
+	if !recipe.VisibleTo(claims.ID) {
+		return model.MealPlanEntry{}, errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+	}
+
+	entry := model.MealPlanEntry{
+		UserID:       claims.ID,
+		Date:         date,
+		Slot:         request.Slot,
+		FoodRecipeID: recipe.ID,
+		Servings:     request.Servings,
+	}
+
+	if err := service.Repository.Create(&entry); err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "create meal plan entry")
+	}
+
+	entry.FoodRecipe = recipe
+	if entry.Servings != nil {
+		entry.FoodRecipe = foodrecipe.Scale(entry.FoodRecipe, *entry.Servings)
+	}
+
+	return entry, nil
+}
+
+func (service Service) DeleteEntry(id string, claims model.Claims) error {
+	entry, err := service.Repository.GetByID(id)
+	if err != nil {
+		return errors.Wrap(err, "find meal plan entry")
+	}
+
+	if entry.UserID != claims.ID {
+		return global.ErrorForbidden
+	}
+
+	return service.Repository.Delete(entry.ID)
+}
+
+// CopyWeek plans the meals of the week holding request.From again in the week
+// holding request.To, day by day. Meals already planned there are kept, and
+// recipes that can no longer be opened are not copied.
+func (service Service) CopyWeek(request dto.MealPlanCopyRequest, claims model.Claims) (model.MealPlan, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.MealPlan{}, errors.Wrap(err, "request invalid")
+	}
+
+	from, err := ParseDate(request.From)
+	if err != nil {
+		return model.MealPlan{}, err
+	}
+
+	to, err := ParseDate(request.To)
+	if err != nil {
+		return model.MealPlan{}, err
+	}
+
+	source, target := WeekStart(from), WeekStart(to)
+	if source.Equal(target) {
+		return model.MealPlan{}, errors.Wrap(global.ErrorInvalidRange, "the weeks are the same")
+	}
+
+	plan, err := service.getPlan(claims.ID, source, source.AddDate(0, 0, 6))
+	if err != nil {
+		return model.MealPlan{}, err
+	}
+
+	days := int(target.Sub(source).Hours() / 24)
+
+	copies := make(model.MealPlanEntries, 0, len(plan.Entries))
+	for _, entry := range plan.Entries {
+		copies = append(copies, model.MealPlanEntry{
+			UserID:       claims.ID,
+			Date:         entry.Date.AddDate(0, 0, days),
+			Slot:         entry.Slot,
+			FoodRecipeID: entry.FoodRecipeID,
+			Servings:     entry.Servings,
+		})
+	}
+
+	if err := service.Repository.CreateMany(copies); err != nil {
+		return model.MealPlan{}, errors.Wrap(err, "copy meal plan")
+	}
+
+	return service.getPlan(claims.ID, target, target.AddDate(0, 0, 6))
+}
+
+func (service Service) ClearDay(date string, claims model.Claims) error {
+	day, err := ParseDate(date)
+	if err != nil {
+		return err
+	}
+
+	if err := service.Repository.DeleteDay(claims.ID, day); err != nil {
+		return errors.Wrap(err, "clear meal plan day")
+	}
+
+	return nil
+}
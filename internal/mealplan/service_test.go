@@ -0,0 +1,185 @@
+package mealplan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/mealplan"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+const (
+	ownerID    = "owner"
+	strangerID = "stranger"
+)
+
+func servings(value int) *int {
+	return &value
+}
+
+type ServiceGetTestSuite struct {
+	suite.Suite
+
+	service mealplan.IService
+	repo    *MockIRepository
+	recipes *MockRecipeRepository
+}
+
+func (suite *ServiceGetTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.recipes = new(MockRecipeRepository)
+	suite.service = &mealplan.Service{
+		Repository: suite.repo,
+		Recipes:    suite.recipes,
+		Now:        func() time.Time { return date("2026-10-18") },
+	}
+
+	suite.repo.On("Get", ownerID, date("2026-10-12"), date("2026-10-18")).Return(model.MealPlanEntries{
+		{ID: 1, UserID: ownerID, Date: date("2026-10-12"), Slot: model.MealSlotDinner, FoodRecipeID: 10, Servings: servings(4)},
+		{ID: 2, UserID: ownerID, Date: date("2026-10-13"), Slot: model.MealSlotLunch, FoodRecipeID: 11},
+		{ID: 3, UserID: ownerID, Date: date("2026-10-14"), Slot: model.MealSlotLunch, FoodRecipeID: 12},
+	}, nil)
+
+	// Recipe 12 was deleted, so it is not found; 11 became a draft of someone else
+	quantity := 100.0
+	suite.recipes.On("GetByIDs", []uint{10, 11, 12}).Return(model.FoodRecipes{
+		{Model: gorm.Model{ID: 10}, Servings: 2, Status: model.RecipeStatusPublished, Ingredients: model.RecipeIngredients{{Name: "Rice", Quantity: &quantity, Unit: "g"}}},
+		{Model: gorm.Model{ID: 11}, Servings: 2, Status: model.RecipeStatusDraft, UserID: strangerID},
+	}, nil)
+}
+
+func (suite *ServiceGetTestSuite) TestLeaveOutRecipesThatCannotBeOpened() {
+	plan, err := suite.service.Get(model.MealPlanQuery{}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Len(plan.Entries, 1)
+	suite.Equal(uint(1), plan.Entries[0].ID)
+}
+
+func (suite *ServiceGetTestSuite) TestScaleRecipeToServings() {
+	plan, err := suite.service.Get(model.MealPlanQuery{}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	recipe := plan.Entries[0].FoodRecipe
+	suite.Equal(4, recipe.Servings)
+	suite.Equal(200.0, *recipe.Ingredients[0].Quantity)
+}
+
+func (suite *ServiceGetTestSuite) TestListEveryDayOfTheWeek() {
+	plan, err := suite.service.Get(model.MealPlanQuery{}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	response := plan.ToResponse()
+	suite.Len(response.Days, 7)
+	suite.Equal("2026-10-12", response.Days[0].Date)
+	suite.Len(response.Days[0].Entries, 1)
+	suite.Empty(response.Days[1].Entries)
+}
+
+func TestServiceGet(t *testing.T) {
+	suite.Run(t, new(ServiceGetTestSuite))
+}
+
+type ServiceAddEntryTestSuite struct {
+	suite.Suite
+
+	service mealplan.IService
+	repo    *MockIRepository
+	recipes *MockRecipeRepository
+}
+
+func (suite *ServiceAddEntryTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.recipes = new(MockRecipeRepository)
+	suite.service = &mealplan.Service{
+		Repository: suite.repo,
+		Recipes:    suite.recipes,
+	}
+
+	suite.recipes.On("GetByID", "10").Return(model.FoodRecipe{Model: gorm.Model{ID: 10}, Status: model.RecipeStatusPublished, UserID: strangerID}, nil)
+	suite.recipes.On("GetByID", "11").Return(model.FoodRecipe{Model: gorm.Model{ID: 11}, Status: model.RecipeStatusPrivate, UserID: strangerID}, nil)
+	suite.recipes.On("GetByID", mock.Anything).Return(model.FoodRecipe{}, gorm.ErrRecordNotFound)
+	suite.repo.On("Create", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceAddEntryTestSuite) TestPlanRecipe() {
+	entry, err := suite.service.AddEntry(dto.MealPlanEntryRequest{Date: "2026-10-19", Slot: "breakfast", RecipeID: 10}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal(uint(10), entry.FoodRecipe.ID)
+	suite.repo.AssertCalled(suite.T(), "Create", mock.MatchedBy(func(entry *model.MealPlanEntry) bool {
+		return entry.UserID == ownerID && entry.Date.Equal(date("2026-10-19")) &&
+			entry.Slot == model.MealSlotBreakfast && entry.FoodRecipeID == 10 && entry.Servings == nil
+	}))
+}
+
+func (suite *ServiceAddEntryTestSuite) TestNotFoundWhenPrivateRecipeOfSomeoneElse() {
+	_, err := suite.service.AddEntry(dto.MealPlanEntryRequest{Date: "2026-10-19", Slot: "lunch", RecipeID: 11}, model.Claims{ID: ownerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceAddEntryTestSuite) TestNotFoundWhenRecipeDeleted() {
+	_, err := suite.service.AddEntry(dto.MealPlanEntryRequest{Date: "2026-10-19", Slot: "lunch", RecipeID: 12}, model.Claims{ID: ownerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func TestServiceAddEntry(t *testing.T) {
+	suite.Run(t, new(ServiceAddEntryTestSuite))
+}
+
+type ServiceCopyWeekTestSuite struct {
+	suite.Suite
+
+	service mealplan.IService
+	repo    *MockIRepository
+	recipes *MockRecipeRepository
+}
+
+func (suite *ServiceCopyWeekTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.recipes = new(MockRecipeRepository)
+	suite.service = &mealplan.Service{
+		Repository: suite.repo,
+		Recipes:    suite.recipes,
+	}
+
+	suite.repo.On("Get", ownerID, date("2026-10-12"), date("2026-10-18")).Return(model.MealPlanEntries{
+		{ID: 1, UserID: ownerID, Date: date("2026-10-12"), Slot: model.MealSlotDinner, FoodRecipeID: 10, Servings: servings(4)},
+		{ID: 2, UserID: ownerID, Date: date("2026-10-18"), Slot: model.MealSlotSnack, FoodRecipeID: 12},
+	}, nil)
+	suite.repo.On("Get", ownerID, date("2026-10-19"), date("2026-10-25")).Return(model.MealPlanEntries{}, nil)
+	suite.repo.On("CreateMany", mock.Anything).Return(nil)
+	suite.recipes.On("GetByIDs", mock.Anything).Return(model.FoodRecipes{
+		{Model: gorm.Model{ID: 10}, Status: model.RecipeStatusPublished},
+	}, nil)
+}
+
+func (suite *ServiceCopyWeekTestSuite) TestCopyDayByDayWithoutDeletedRecipes() {
+	plan, err := suite.service.CopyWeek(dto.MealPlanCopyRequest{From: "2026-10-15", To: "2026-10-21"}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal(date("2026-10-19"), plan.From)
+	suite.repo.AssertCalled(suite.T(), "CreateMany", model.MealPlanEntries{
+		{UserID: ownerID, Date: date("2026-10-19"), Slot: model.MealSlotDinner, FoodRecipeID: 10, Servings: servings(4)},
+	})
+}
+
+func (suite *ServiceCopyWeekTestSuite) TestErrorWhenSameWeek() {
+	_, err := suite.service.CopyWeek(dto.MealPlanCopyRequest{From: "2026-10-12", To: "2026-10-18"}, model.Claims{ID: ownerID})
+	suite.ErrorIs(err, global.ErrorInvalidRange)
+
+	suite.repo.AssertNotCalled(suite.T(), "CreateMany", mock.Anything)
+}
+
+func TestServiceCopyWeek(t *testing.T) {
+	suite.Run(t, new(ServiceCopyWeekTestSuite))
+}
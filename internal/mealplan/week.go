@@ -0,0 +1,53 @@
+package mealplan
+
+import (
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/pkg/errors"
+)
+
+// MaxDays is the longest range a plan is read for at once.
+const MaxDays = 62
+
+// ParseDate reads a plain date as UTC midnight, the way dates are stored.
+func ParseDate(text string) (time.Time, error) {
+	date, err := time.Parse(model.DateLayout, text)
+	if err != nil {
+		return time.Time{}, errors.Wrap(global.ErrorInvalidRange, err.Error())
+	}
+	return date, nil
+}
+
+// WeekStart returns the Monday of the week holding date.
+func WeekStart(date time.Time) time.Time {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(date.Weekday()) + 6) % 7 // days since Monday
+	return date.AddDate(0, 0, -offset)
+}
+
+// Dates returns the first and last date a query asks for. today picks the
+// week when the query names none.
+func Dates(query model.MealPlanQuery, today time.Time) (time.Time, time.Time, error) {
+	switch {
+	case query.Week != nil:
+		from := WeekStart(*query.Week)
+		return from, from.AddDate(0, 0, 6), nil
+	case query.From == nil && query.To == nil:
+		from := WeekStart(today)
+		return from, from.AddDate(0, 0, 6), nil
+	case query.From == nil || query.To == nil:
+		return time.Time{}, time.Time{}, errors.Wrap(global.ErrorInvalidRange, "from and to go together")
+	}
+
+	from, to := *query.From, *query.To
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, errors.Wrap(global.ErrorInvalidRange, "to is before from")
+	}
+	if to.Sub(from) >= MaxDays*24*time.Hour {
+		return time.Time{}, time.Time{}, errors.Wrapf(global.ErrorInvalidRange, "at most %d days", MaxDays)
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,68 @@
+package mealplan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/mealplan"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(text string) time.Time {
+	parsed, _ := mealplan.ParseDate(text)
+	return parsed
+}
+
+func TestWeekStart(t *testing.T) {
+	assert.Equal(t, date("2026-10-12"), mealplan.WeekStart(date("2026-10-12")), "Monday")
+	assert.Equal(t, date("2026-10-12"), mealplan.WeekStart(date("2026-10-15")), "Thursday")
+	assert.Equal(t, date("2026-10-12"), mealplan.WeekStart(date("2026-10-18")), "Sunday")
+	assert.Equal(t, date("2026-09-28"), mealplan.WeekStart(date("2026-10-01")), "across months")
+}
+
+func TestDates(t *testing.T) {
+	today := date("2026-10-18")
+
+	t.Run("current week by default", func(t *testing.T) {
+		from, to, err := mealplan.Dates(model.MealPlanQuery{}, today)
+		assert.NoError(t, err)
+		assert.Equal(t, date("2026-10-12"), from)
+		assert.Equal(t, date("2026-10-18"), to)
+	})
+
+	t.Run("week holding a date", func(t *testing.T) {
+		week := date("2026-10-21")
+		from, to, err := mealplan.Dates(model.MealPlanQuery{Week: &week}, today)
+		assert.NoError(t, err)
+		assert.Equal(t, date("2026-10-19"), from)
+		assert.Equal(t, date("2026-10-25"), to)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		from, to := date("2026-10-01"), date("2026-10-31")
+		gotFrom, gotTo, err := mealplan.Dates(model.MealPlanQuery{From: &from, To: &to}, today)
+		assert.NoError(t, err)
+		assert.Equal(t, from, gotFrom)
+		assert.Equal(t, to, gotTo)
+	})
+
+	t.Run("range without an end", func(t *testing.T) {
+		from := date("2026-10-01")
+		_, _, err := mealplan.Dates(model.MealPlanQuery{From: &from}, today)
+		assert.ErrorIs(t, err, global.ErrorInvalidRange)
+	})
+
+	t.Run("range backward", func(t *testing.T) {
+		from, to := date("2026-10-31"), date("2026-10-01")
+		_, _, err := mealplan.Dates(model.MealPlanQuery{From: &from, To: &to}, today)
+		assert.ErrorIs(t, err, global.ErrorInvalidRange)
+	})
+
+	t.Run("range too long", func(t *testing.T) {
+		from, to := date("2026-10-01"), date("2026-12-31")
+		_, _, err := mealplan.Dates(model.MealPlanQuery{From: &from, To: &to}, today)
+		assert.ErrorIs(t, err, global.ErrorInvalidRange)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/klins/devpool/go-day6/wongnok/config"
+	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 )
 
@@ -44,3 +45,42 @@ func Authorize(
 		ctx.Next()
 	}
 }
+
+// OptionalAuthorize sets the claims like Authorize when the request carries a
+// bearer token, and lets anonymous requests through without claims. A token
+// that does not verify is still rejected.
+func OptionalAuthorize(
+	verifier config.IOIDCTokenVerifier,
+) gin.HandlerFunc {
+	authorize := Authorize(verifier)
+
+	return func(ctx *gin.Context) {
+		if !strings.HasPrefix(ctx.GetHeader("Authorization"), "Bearer ") {
+			ctx.Next()
+			return
+		}
+
+		authorize(ctx)
+	}
+}
+
+// RequireRole lets the request through only when the claims set by Authorize
+// carry the realm role.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		claims, err := helper.DecodeClaims(ctx)
+		if err != nil {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+			ctx.Abort()
+			return
+		}
+
+		if !claims.HasRole(role) {
+			ctx.JSON(http.StatusForbidden, gin.H{"message": "You do not have permission to perform this action"})
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
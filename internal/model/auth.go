@@ -21,8 +21,36 @@ func (cred Credential) ToResponse() dto.CredentialResponse {
 	}
 }
 
+// RoleAdmin is the Keycloak realm role allowed to manage shared data such as
+// tag groups.
+const RoleAdmin = "admin"
+
+// RoleModerator is the Keycloak realm role allowed to remove what other users
+// wrote, such as comments.
+const RoleModerator = "moderator"
+
 type Claims struct {
-	ID        string `json:"sub" validate:"required"`
-	FirstName string `json:"given_name" validate:"required"`
-	LastName  string `json:"family_name" validate:"required"`
+	ID          string      `json:"sub" validate:"required"`
+	FirstName   string      `json:"given_name" validate:"required"`
+	LastName    string      `json:"family_name" validate:"required"`
+	RealmAccess RealmAccess `json:"realm_access"`
+}
+
+type RealmAccess struct {
+	Roles []string `json:"roles"`
+}
+
+func (claims Claims) HasRole(role string) bool {
+	for _, granted := range claims.RealmAccess.Roles {
+		if granted == role {
+			return true
+		}
+	}
+	return false
+}
+
+// CanModerate tells whether the user may remove what others wrote. Admins
+// moderate too.
+func (claims Claims) CanModerate() bool {
+	return claims.HasRole(RoleModerator) || claims.HasRole(RoleAdmin)
 }
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+)
+
+// BulkQuery picks the file format of a bulk export or import. A dry run
+// checks every row against the database and rolls the import back.
+type BulkQuery struct {
+	Format string `form:"format" binding:"required,oneof=csv jsonl"`
+	DryRun bool   `form:"dryRun"`
+}
+
+// BulkReport is the outcome of a bulk import. Rows counts the rows read and
+// Imported the ones that went in, or would have in a dry run. The import is
+// only committed when no row failed.
+type BulkReport struct {
+	Rows      int
+	Imported  int
+	DryRun    bool
+	Committed bool
+	Errors    BulkRowErrors
+}
+
+// BulkRowError tells why a row was not imported. Row counts from 1, the
+// first row after the CSV header or the first line of JSONL.
+type BulkRowError struct {
+	Row     int
+	Name    string
+	Message string
+}
+
+type BulkRowErrors []BulkRowError
+
+// Sort puts the errors in the order of the rows.
+func (rowErrors BulkRowErrors) Sort() {
+	sort.SliceStable(rowErrors, func(i, j int) bool { return rowErrors[i].Row < rowErrors[j].Row })
+}
+
+func (report BulkReport) ToResponse() dto.BulkReportResponse {
+	errors := make([]dto.BulkRowErrorResponse, 0, len(report.Errors))
+	for _, rowError := range report.Errors {
+		errors = append(errors, dto.BulkRowErrorResponse{
+			Row:     rowError.Row,
+			Name:    rowError.Name,
+			Message: rowError.Message,
+		})
+	}
+
+	return dto.BulkReportResponse{
+		Rows:      report.Rows,
+		Imported:  report.Imported,
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+		Errors:    errors,
+	}
+}
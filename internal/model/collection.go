@@ -0,0 +1,104 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"gorm.io/gorm"
+)
+
+// Collection visibilities. Private collections are only shown to their owner.
+const (
+	CollectionVisibilityPublic  = "public"
+	CollectionVisibilityPrivate = "private"
+)
+
+// Collection is a cookbook a user curates out of recipes, kept in the order
+// the owner arranged them.
+type Collection struct {
+	gorm.Model
+	UserID        string
+	User          User
+	Title         string
+	Description   string
+	CoverImageURL *string
+	Visibility    string `gorm:"default:private"`
+	// RecipeCount is the number of live recipes the viewer may open, only
+	// filled when collections are read with it. It is not a column, so a
+	// query joining other tables has to select collections.* itself.
+	RecipeCount int64 `gorm:"->;-:migration"`
+}
+
+// VisibleTo tells whether the user may open the collection. An empty user ID
+// is an anonymous visitor.
+func (collection Collection) VisibleTo(userID string) bool {
+	if collection.Visibility == CollectionVisibilityPublic {
+		return true
+	}
+	return userID != "" && collection.UserID == userID
+}
+
+func (collection Collection) FromRequest(request dto.CollectionRequest, claims Claims) Collection {
+	visibility := request.Visibility
+	if visibility == "" {
+		visibility = collection.Visibility
+	}
+	if visibility == "" {
+		visibility = CollectionVisibilityPrivate
+	}
+
+	return Collection{
+		Model:         collection.Model,
+		UserID:        claims.ID,
+		User:          collection.User,
+		Title:         strings.TrimSpace(request.Title),
+		Description:   request.Description,
+		CoverImageURL: request.CoverImageURL,
+		Visibility:    visibility,
+		RecipeCount:   collection.RecipeCount,
+	}
+}
+
+func (collection Collection) ToResponse() dto.CollectionResponse {
+	return dto.CollectionResponse{
+		ID:            collection.ID,
+		Title:         collection.Title,
+		Description:   collection.Description,
+		CoverImageURL: collection.CoverImageURL,
+		Visibility:    collection.Visibility,
+		RecipeCount:   collection.RecipeCount,
+		User:          collection.User.ToResponse(),
+		CreatedAt:     collection.CreatedAt,
+		UpdatedAt:     collection.UpdatedAt,
+	}
+}
+
+type Collections []Collection
+
+func (collections Collections) ToResponse(total int64) dto.CollectionsResponse {
+	var results = make([]dto.CollectionResponse, 0)
+
+	for _, collection := range collections {
+		results = append(results, collection.ToResponse())
+	}
+
+	return dto.CollectionsResponse{
+		Total:   total,
+		Results: results,
+	}
+}
+
+// CollectionRecipe places a recipe in a collection. Entries of recipes that
+// were deleted stay, and are skipped whenever the collection is read.
+type CollectionRecipe struct {
+	CollectionID uint `gorm:"primaryKey"`
+	FoodRecipeID uint `gorm:"primaryKey"`
+	Position     int
+}
+
+// CollectionQuery filters GET /collections, e.g. ?userId= for the public
+// collections of one user.
+type CollectionQuery struct {
+	PageQuery
+	UserID string `form:"userId"`
+}
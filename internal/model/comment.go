@@ -0,0 +1,86 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"gorm.io/gorm"
+)
+
+// Comment is written on a recipe, either starting a thread or replying in
+// one. Threads are one level deep: ParentID is always a top-level comment. A
+// top-level comment with a rating is a review.
+type Comment struct {
+	gorm.Model
+	FoodRecipeID uint
+	UserID       string
+	User         User
+	ParentID     *uint
+	Body         string
+	RatingID     *uint
+	Rating       *Rating
+	EditedAt     *time.Time
+	// ReplyCount is the number of live replies, only filled when threads are
+	// read with it. It is not a column, so a query joining other tables has
+	// to select comments.* itself.
+	ReplyCount int64 `gorm:"->;-:migration"`
+}
+
+// FromRequest leaves the rating and the parent to the service, which checks
+// them first.
+func (comment Comment) FromRequest(request dto.CommentRequest, claims Claims) Comment {
+	return Comment{
+		Model:        comment.Model,
+		FoodRecipeID: comment.FoodRecipeID,
+		UserID:       claims.ID,
+		User:         comment.User,
+		ParentID:     comment.ParentID,
+		Body:         strings.TrimSpace(request.Body),
+		RatingID:     comment.RatingID,
+		Rating:       comment.Rating,
+		EditedAt:     comment.EditedAt,
+		ReplyCount:   comment.ReplyCount,
+	}
+}
+
+// ToResponse hides what a deleted comment said and who said it. Deleted
+// comments are only read as the head of a thread that still has replies.
+func (comment Comment) ToResponse() dto.CommentResponse {
+	response := dto.CommentResponse{
+		ID:           comment.ID,
+		FoodRecipeID: comment.FoodRecipeID,
+		ParentID:     comment.ParentID,
+		ReplyCount:   comment.ReplyCount,
+		Deleted:      comment.DeletedAt.Valid,
+		CreatedAt:    comment.CreatedAt,
+	}
+
+	if comment.DeletedAt.Valid {
+		return response
+	}
+
+	user := comment.User.ToResponse()
+	response.Body = comment.Body
+	response.User = &user
+	response.EditedAt = comment.EditedAt
+	if comment.Rating != nil {
+		response.Score = &comment.Rating.Score
+	}
+
+	return response
+}
+
+type Comments []Comment
+
+func (comments Comments) ToResponse() dto.CommentsResponse {
+	var results = make([]dto.CommentResponse, 0)
+
+	for _, comment := range comments {
+		results = append(results, comment.ToResponse())
+	}
+
+	return dto.CommentsResponse{
+		Results: results,
+	}
+}
@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCommentToResponse(t *testing.T) {
+	review := model.Comment{
+		Model:      gorm.Model{ID: 10},
+		UserID:     "owner",
+		User:       model.User{ID: "owner", FirstName: "Somchai"},
+		Body:       "Lovely",
+		Rating:     &model.Rating{Score: 4},
+		ReplyCount: 2,
+	}
+
+	t.Run("ShouldShowReviewWithScore", func(t *testing.T) {
+		response := review.ToResponse()
+
+		assert.Equal(t, "Lovely", response.Body)
+		assert.Equal(t, 4.0, *response.Score)
+		assert.Equal(t, "owner", response.User.ID)
+		assert.False(t, response.Deleted)
+	})
+
+	t.Run("ShouldHideDeletedComment", func(t *testing.T) {
+		deleted := review
+		deleted.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+
+		response := deleted.ToResponse()
+
+		assert.True(t, response.Deleted)
+		assert.Empty(t, response.Body)
+		assert.Nil(t, response.Score)
+		assert.Nil(t, response.User)
+		assert.Equal(t, int64(2), response.ReplyCount)
+	})
+}
@@ -0,0 +1,113 @@
+package model
+
+import "github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+
+// DietaryFlags are the effective labels of a recipe: what the rules derived
+// from its ingredients, with the author's overrides applied.
+type DietaryFlags struct {
+	Vegetarian    bool
+	Vegan         bool
+	HalalFriendly bool
+	GlutenFree    bool
+}
+
+// DietaryOverrides are set by the author. Nil keeps the derived value.
+type DietaryOverrides struct {
+	Vegetarian    *bool
+	Vegan         *bool
+	HalalFriendly *bool
+	GlutenFree    *bool
+}
+
+func (overrides DietaryOverrides) FromRequest(request dto.DietaryRequest) DietaryOverrides {
+	return DietaryOverrides{
+		Vegetarian:    request.Vegetarian,
+		Vegan:         request.Vegan,
+		HalalFriendly: request.HalalFriendly,
+		GlutenFree:    request.GlutenFree,
+	}
+}
+
+// ToRequest returns the request that sets these overrides and the allergen
+// overrides given.
+func (overrides DietaryOverrides) ToRequest(allergens map[string]bool) dto.DietaryRequest {
+	return dto.DietaryRequest{
+		Vegetarian:    overrides.Vegetarian,
+		Vegan:         overrides.Vegan,
+		HalalFriendly: overrides.HalalFriendly,
+		GlutenFree:    overrides.GlutenFree,
+		Allergens:     allergens,
+	}
+}
+
+// Apply returns the derived flags with the overrides on top.
+func (overrides DietaryOverrides) Apply(flags DietaryFlags) DietaryFlags {
+	override := func(value *bool, derived bool) bool {
+		if value != nil {
+			return *value
+		}
+		return derived
+	}
+
+	return DietaryFlags{
+		Vegetarian:    override(overrides.Vegetarian, flags.Vegetarian),
+		Vegan:         override(overrides.Vegan, flags.Vegan),
+		HalalFriendly: override(overrides.HalalFriendly, flags.HalalFriendly),
+		GlutenFree:    override(overrides.GlutenFree, flags.GlutenFree),
+	}
+}
+
+// RecipeAllergen is an allergen that was either detected in the ingredients
+// or set by the author. Override, when set, wins over detection.
+type RecipeAllergen struct {
+	FoodRecipeID uint   `gorm:"primaryKey"`
+	Allergen     string `gorm:"primaryKey"`
+	Detected     bool
+	Override     *bool
+}
+
+func (allergen RecipeAllergen) Present() bool {
+	if allergen.Override != nil {
+		return *allergen.Override
+	}
+	return allergen.Detected
+}
+
+type RecipeAllergens []RecipeAllergen
+
+// FromOverrides keeps only what the author set. Detection is added by the
+// service when the recipe is labelled.
+func (allergens RecipeAllergens) FromOverrides(overrides map[string]bool) RecipeAllergens {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	results := make(RecipeAllergens, 0, len(overrides))
+	for allergen, present := range overrides {
+		results = append(results, RecipeAllergen{Allergen: allergen, Override: &present})
+	}
+	return results
+}
+
+func (allergens RecipeAllergens) Overrides() map[string]bool {
+	overrides := make(map[string]bool)
+	for _, allergen := range allergens {
+		if allergen.Override != nil {
+			overrides[allergen.Allergen] = *allergen.Override
+		}
+	}
+	return overrides
+}
+
+// Present lists the allergens the recipe contains.
+func (allergens RecipeAllergens) Present() []string {
+	var results = make([]string, 0)
+
+	for _, allergen := range allergens {
+		if allergen.Present() {
+			results = append(results, allergen.Allergen)
+		}
+	}
+
+	return results
+}
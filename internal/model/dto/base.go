@@ -3,4 +3,8 @@ package dto
 type BaseListResponse[T any] struct {
 	Total   int64 `json:"total,omitempty"`
 	Results T     `json:"results"`
+
+	// Cursors of the neighbouring pages, left out when there is none
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
 }
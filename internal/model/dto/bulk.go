@@ -0,0 +1,15 @@
+package dto
+
+type BulkReportResponse struct {
+	Rows      int                    `json:"rows"`
+	Imported  int                    `json:"imported"`
+	DryRun    bool                   `json:"dryRun"`
+	Committed bool                   `json:"committed"`
+	Errors    []BulkRowErrorResponse `json:"errors"`
+}
+
+type BulkRowErrorResponse struct {
+	Row     int    `json:"row"`
+	Name    string `json:"name,omitempty"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+type CollectionRequest struct {
+	Title         string  `json:"title" validate:"required,max=255"`
+	Description   string  `json:"description"`
+	CoverImageURL *string `json:"coverImageUrl" validate:"omitempty,url"`
+	Visibility    string  `json:"visibility" validate:"omitempty,oneof=public private"`
+}
+
+type CollectionRecipeRequest struct {
+	RecipeID uint `json:"recipeId" validate:"required"`
+}
+
+// CollectionOrderRequest lists every recipe of a collection the owner can see
+// in its new order. An empty collection takes an empty list.
+type CollectionOrderRequest struct {
+	RecipeIDs []uint `json:"recipeIds" validate:"unique,dive,min=1"`
+}
+
+type CollectionResponse struct {
+	ID            uint         `json:"id"`
+	Title         string       `json:"title"`
+	Description   string       `json:"description"`
+	CoverImageURL *string      `json:"coverImageUrl,omitempty"`
+	Visibility    string       `json:"visibility"`
+	RecipeCount   int64        `json:"recipeCount"`
+	User          UserResponse `json:"user"`
+	CreatedAt     time.Time    `json:"createdAt"`
+	UpdatedAt     time.Time    `json:"updatedAt"`
+}
+
+type CollectionsResponse BaseListResponse[[]CollectionResponse]
@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+// CommentRequest writes a comment. A score makes a top-level comment a review;
+// replies cannot carry one.
+type CommentRequest struct {
+	Body     string   `json:"body" validate:"required,max=5000"`
+	Score    *float64 `json:"score" validate:"omitempty,min=1,max=5"`
+	ParentID *uint    `json:"parentId" validate:"omitempty,min=1"`
+}
+
+type CommentResponse struct {
+	ID           uint          `json:"id"`
+	FoodRecipeID uint          `json:"foodRecipeId"`
+	ParentID     *uint         `json:"parentId,omitempty"`
+	Body         string        `json:"body"`
+	Score        *float64      `json:"score,omitempty"`
+	User         *UserResponse `json:"user,omitempty"`
+	ReplyCount   int64         `json:"replyCount"`
+	Deleted      bool          `json:"deleted"`
+	CreatedAt    time.Time     `json:"createdAt"`
+	EditedAt     *time.Time    `json:"editedAt,omitempty"`
+}
+
+type CommentsResponse BaseListResponse[[]CommentResponse]
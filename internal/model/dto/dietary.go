@@ -0,0 +1,20 @@
+package dto
+
+// DietaryRequest overrides the labels derived from the ingredients. Leave a
+// flag out to keep the derived value. Allergens maps an allergen to whether
+// the recipe contains it.
+type DietaryRequest struct {
+	Vegetarian    *bool           `json:"vegetarian"`
+	Vegan         *bool           `json:"vegan"`
+	HalalFriendly *bool           `json:"halalFriendly"`
+	GlutenFree    *bool           `json:"glutenFree"`
+	Allergens     map[string]bool `json:"allergens" validate:"omitempty,dive,keys,oneof=peanut shellfish fish-sauce egg dairy,endkeys"`
+}
+
+type DietaryResponse struct {
+	Vegetarian    bool     `json:"vegetarian"`
+	Vegan         bool     `json:"vegan"`
+	HalalFriendly bool     `json:"halalFriendly"`
+	GlutenFree    bool     `json:"glutenFree"`
+	Allergens     []string `json:"allergens"`
+}
@@ -6,29 +6,70 @@ import (
 )
 
 type FoodRecipeRequest struct {
-	Name              string
-	Description       string
-	Ingredient        string
-	Instruction       string
-	ImageURL          *string
-	CookingDurationID uint
-	DifficultyID      uint
+	Name              string                    `json:"name" validate:"required"`
+	Description       string                    `json:"description"`
+	Ingredient        string                    `json:"ingredient"`
+	Ingredients       []RecipeIngredientRequest `json:"ingredients" validate:"omitempty,dive"`
+	Instruction       string                    `json:"instruction"`
+	Steps             []RecipeStepRequest       `json:"steps" validate:"omitempty,dive"`
+	ImageURL          *string                   `json:"imageUrl"`
+	Servings          int                       `json:"servings" validate:"omitempty,min=1,max=100"`
+	Status            string                    `json:"status,omitempty" validate:"omitempty,oneof=draft unlisted private"`
+	CookingDurationID uint                      `json:"cookingDurationId" validate:"required"`
+	DifficultyID      uint                      `json:"difficultyId" validate:"required"`
+	TagIDs            []uint                    `json:"tagIds" validate:"omitempty,dive,min=1"`
+	Dietary           *DietaryRequest           `json:"dietary"`
 }
 
 type FoodRecipeResponse struct {
-	ID              uint                    `json:"id"`
-	Name            string                  `json:"name"`
-	Description     string                  `json:"description"`
-	Ingredient      string                  `json:"ingredient"`
-	Instruction     string                  `json:"instruction"`
-	ImageURL        *string                 `json:"imageUrl,omitempty"`
-	CookingDuration CookingDurationResponse `json:"cookingDuration"`
-	Difficulty      DifficultyResponse      `json:"difficulty"`
-	CreatedAt       time.Time               `json:"createdAt"`
-	UpdatedAt       time.Time               `json:"updatedAt"`
-	AverageRating   float64                 `json:"averageRating"` // new
-	User            UserResponse            `json:"user"`          // new, user who created the recipe
+	ID              uint                       `json:"id"`
+	Name            string                     `json:"name"`
+	Description     string                     `json:"description"`
+	Ingredient      string                     `json:"ingredient"`
+	Ingredients     []RecipeIngredientResponse `json:"ingredients"`
+	Instruction     string                     `json:"instruction"`
+	Steps           []RecipeStepResponse       `json:"steps"`
+	ImageURL        *string                    `json:"imageUrl,omitempty"`
+	Thumbnails      *ThumbnailsResponse        `json:"thumbnails,omitempty"`
+	Servings        int                        `json:"servings"`
+	Status          string                     `json:"status"`
+	CookingDuration CookingDurationResponse    `json:"cookingDuration"`
+	Difficulty      DifficultyResponse         `json:"difficulty"`
+	Tags            []TagResponse              `json:"tags"`
+	Dietary         DietaryResponse            `json:"dietary"`
+	CreatedAt       time.Time                  `json:"createdAt"`
+	UpdatedAt       time.Time                  `json:"updatedAt"`
+	AverageRating   float64                    `json:"averageRating"` // new
+	User            UserResponse               `json:"user"`          // new, user who created the recipe
+	Nutrition       *NutritionResponse         `json:"nutrition,omitempty"`
+	RatingCount     int64                      `json:"ratingCount"`
+	RatingScore     float64                    `json:"ratingScore"`
+	CommentCount    int64                      `json:"commentCount"`
+
+	ForkedFromID *uint                       `json:"forkedFromId,omitempty"`
+	Attribution  []RecipeAttributionResponse `json:"attribution,omitempty"`
+}
+
+// RecipeAttributionResponse credits a recipe up the fork chain. ID and name
+// are left out when the viewer may not open that recipe.
+type RecipeAttributionResponse struct {
+	ID   uint         `json:"id,omitempty"`
+	Name string       `json:"name,omitempty"`
+	User UserResponse `json:"user"`
+}
+
+type FoodRecipesResponse struct {
+	BaseListResponse[[]FoodRecipeResponse]
+	Facets *FoodRecipeFacetsResponse `json:"facets,omitempty"`
+}
+
+type FoodRecipeFacetsResponse struct {
+	Difficulties     []FacetCountResponse `json:"difficulties"`
+	CookingDurations []FacetCountResponse `json:"cookingDurations"`
 }
 
-// type FoodRecipesResponse dto.BaseListResponse[[]FoodRecipeResponse]
-type FoodRecipesResponse BaseListResponse[[]FoodRecipeResponse]
+type FacetCountResponse struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
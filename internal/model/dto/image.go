@@ -0,0 +1,7 @@
+package dto
+
+type ThumbnailsResponse struct {
+	Small  string `json:"small"`
+	Medium string `json:"medium"`
+	Large  string `json:"large"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+type MealPlanEntryRequest struct {
+	Date     string `json:"date" validate:"required,datetime=2006-01-02"`
+	Slot     string `json:"slot" validate:"required,oneof=breakfast lunch dinner snack"`
+	RecipeID uint   `json:"recipeId" validate:"required"`
+	Servings *int   `json:"servings" validate:"omitempty,min=1,max=100"`
+}
+
+// MealPlanCopyRequest copies the week holding From into the week holding To.
+type MealPlanCopyRequest struct {
+	From string `json:"from" validate:"required,datetime=2006-01-02"`
+	To   string `json:"to" validate:"required,datetime=2006-01-02"`
+}
+
+type MealPlanEntryResponse struct {
+	ID       uint               `json:"id"`
+	Date     string             `json:"date"`
+	Slot     string             `json:"slot"`
+	Servings int                `json:"servings"`
+	Recipe   FoodRecipeResponse `json:"recipe"`
+}
+
+type MealPlanDayResponse struct {
+	Date    string                  `json:"date"`
+	Entries []MealPlanEntryResponse `json:"entries"`
+}
+
+type MealPlanResponse struct {
+	From string                `json:"from"`
+	To   string                `json:"to"`
+	Days []MealPlanDayResponse `json:"days"`
+}
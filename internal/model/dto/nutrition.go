@@ -0,0 +1,20 @@
+package dto
+
+type NutritionResponse struct {
+	Servings   int                           `json:"servings"`
+	PerServing NutrientsResponse             `json:"perServing"`
+	Unmatched  []UnmatchedIngredientResponse `json:"unmatched"`
+}
+
+type NutrientsResponse struct {
+	Calories float64 `json:"calories"` // kcal
+	Protein  float64 `json:"protein"`  // g
+	Fat      float64 `json:"fat"`      // g
+	Carbs    float64 `json:"carbs"`    // g
+	Sodium   float64 `json:"sodium"`   // mg
+}
+
+type UnmatchedIngredientResponse struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+type PantryItemRequest struct {
+	Name      string   `json:"name" validate:"required,max=255"`
+	Quantity  *float64 `json:"quantity" validate:"omitempty,gt=0"`
+	Unit      string   `json:"unit" validate:"max=50"`
+	ExpiresOn string   `json:"expiresOn" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type PantryItemResponse struct {
+	ID           uint     `json:"id"`
+	Name         string   `json:"name"`
+	Quantity     *float64 `json:"quantity,omitempty"`
+	Unit         string   `json:"unit,omitempty"`
+	ExpiresOn    string   `json:"expiresOn,omitempty"`
+	ExpiringSoon bool     `json:"expiringSoon"`
+}
+
+type PantryItemsResponse BaseListResponse[[]PantryItemResponse]
+
+type CookableRecipeResponse struct {
+	Recipe          FoodRecipeResponse   `json:"recipe"`
+	IngredientCount int                  `json:"ingredientCount"`
+	CoveredCount    int                  `json:"coveredCount"`
+	Coverage        float64              `json:"coverage"`
+	Missing         []string             `json:"missing"`
+	Expiring        []PantryItemResponse `json:"expiring"`
+}
+
+type CookableRecipesResponse BaseListResponse[[]CookableRecipeResponse]
@@ -1,12 +1,16 @@
 package dto
 
+import "time"
+
+// RatingRequest scores a recipe from 1 to 5 stars, in steps of a tenth.
 type RatingRequest struct {
-	Score float64 `validate:"required"`
+	Score float64 `validate:"required,min=1,max=5"`
 }
 
 type RatingResponse struct {
-	Score        float64 `json:"score"`
-	FoodRecipeID uint    `json:"foodRecipeID"`
+	Score        float64   `json:"score"`
+	FoodRecipeID uint      `json:"foodRecipeID"`
+	UpdatedAt    time.Time `json:"updatedAt"`
 }
 
 type RatingsResponse BaseListResponse[[]RatingResponse]
@@ -0,0 +1,17 @@
+package dto
+
+type RecipeIngredientRequest struct {
+	Name     string   `json:"name" validate:"required,max=255"`
+	Quantity *float64 `json:"quantity" validate:"omitempty,gt=0"`
+	Unit     string   `json:"unit" validate:"max=50"`
+	Note     string   `json:"note"`
+}
+
+type RecipeIngredientResponse struct {
+	ID       uint     `json:"id"`
+	Name     string   `json:"name"`
+	Quantity *float64 `json:"quantity,omitempty"`
+	Unit     string   `json:"unit,omitempty"`
+	Note     string   `json:"note,omitempty"`
+	Position int      `json:"position"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+type RecipeRevisionResponse struct {
+	Number    int                `json:"number"`
+	Editor    UserResponse       `json:"editor"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Recipe    *FoodRecipeRequest `json:"recipe,omitempty"`
+}
+
+type RecipeRevisionsResponse BaseListResponse[[]RecipeRevisionResponse]
+
+type FieldChangeResponse struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+type RevisionDiffResponse struct {
+	From    int                   `json:"from"`
+	To      int                   `json:"to"`
+	Changes []FieldChangeResponse `json:"changes"`
+}
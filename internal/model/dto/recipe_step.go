@@ -0,0 +1,16 @@
+package dto
+
+type RecipeStepRequest struct {
+	Position        int     `json:"position" validate:"required,min=1"`
+	Text            string  `json:"text" validate:"required"`
+	DurationSeconds *int    `json:"durationSeconds" validate:"omitempty,min=1"`
+	ImageURL        *string `json:"imageUrl" validate:"omitempty,url"`
+}
+
+type RecipeStepResponse struct {
+	ID              uint    `json:"id"`
+	Position        int     `json:"position"`
+	Text            string  `json:"text"`
+	DurationSeconds *int    `json:"durationSeconds,omitempty"`
+	ImageURL        *string `json:"imageUrl,omitempty"`
+}
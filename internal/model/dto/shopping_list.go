@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+// ShoppingListRecipeRequest puts a recipe on a list, for its own servings
+// unless Servings is given.
+type ShoppingListRecipeRequest struct {
+	RecipeID uint `json:"recipeId" validate:"required"`
+	Servings int  `json:"servings" validate:"omitempty,min=1,max=100"`
+}
+
+// ShoppingListRequest generates a list out of recipes, the meals planned from
+// one date to another, or both.
+type ShoppingListRequest struct {
+	Name    string                      `json:"name" validate:"max=255"`
+	Recipes []ShoppingListRecipeRequest `json:"recipes" validate:"required_without=From,omitempty,dive"`
+	From    string                      `json:"from" validate:"required_with=To,omitempty,datetime=2006-01-02"`
+	To      string                      `json:"to" validate:"required_with=From,omitempty,datetime=2006-01-02"`
+}
+
+type ShoppingListItemRequest struct {
+	Checked bool `json:"checked"`
+}
+
+type ShoppingListItemResponse struct {
+	ID       uint     `json:"id"`
+	Name     string   `json:"name"`
+	Quantity *float64 `json:"quantity,omitempty"`
+	Unit     string   `json:"unit,omitempty"`
+	Checked  bool     `json:"checked"`
+}
+
+type ShoppingListAisleResponse struct {
+	Aisle string                     `json:"aisle"`
+	Items []ShoppingListItemResponse `json:"items"`
+}
+
+type ShoppingListResponse struct {
+	ID        uint                        `json:"id"`
+	Name      string                      `json:"name"`
+	Aisles    []ShoppingListAisleResponse `json:"aisles,omitempty"`
+	CreatedAt time.Time                   `json:"createdAt"`
+	UpdatedAt time.Time                   `json:"updatedAt"`
+}
+
+type ShoppingListsResponse BaseListResponse[[]ShoppingListResponse]
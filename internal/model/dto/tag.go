@@ -0,0 +1,29 @@
+package dto
+
+type TagGroupRequest struct {
+	Name string `validate:"required,max=100"`
+	Slug string `validate:"required,max=100,lowercase"`
+}
+
+type TagGroupResponse struct {
+	ID   uint          `json:"id"`
+	Name string        `json:"name"`
+	Slug string        `json:"slug"`
+	Tags []TagResponse `json:"tags,omitempty"`
+}
+
+type TagGroupsResponse BaseListResponse[[]TagGroupResponse]
+
+type TagRequest struct {
+	TagGroupID uint   `validate:"required"`
+	Name       string `validate:"required,max=100"`
+}
+
+type TagResponse struct {
+	ID          uint              `json:"id"`
+	Name        string            `json:"name"`
+	Group       *TagGroupResponse `json:"group,omitempty"`
+	RecipeCount *int64            `json:"recipeCount,omitempty"`
+}
+
+type TagsResponse BaseListResponse[[]TagResponse]
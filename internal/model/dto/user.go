@@ -1,10 +1,11 @@
 package dto
 
 type UserResponse struct {
-	ID        string `json:"id"`
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
-	ImageURL  string `json:"imageUrl"`
+	ID         string              `json:"id"`
+	FirstName  string              `json:"firstName"`
+	LastName   string              `json:"lastName"`
+	ImageURL   string              `json:"imageUrl"`
+	Thumbnails *ThumbnailsResponse `json:"thumbnails,omitempty"`
 }
 
 type UserRequest struct {
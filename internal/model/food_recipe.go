@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
 	"gorm.io/gorm"
 )
@@ -10,45 +12,174 @@ type FoodRecipe struct {
 	Name              string
 	Description       string
 	Ingredient        string
+	Ingredients       RecipeIngredients
 	Instruction       string
+	Steps             RecipeSteps
 	ImageURL          *string
+	Servings          int    `gorm:"default:1"`
+	Status            string `gorm:"default:draft"`
 	CookingDurationID uint
 	CookingDuration   CookingDuration
 	DifficultyID      uint
 	Difficulty        Difficulty
-	Ratings           Ratings // new
-	AverageRating     float64 `gorm:"-"` // new
-	UserID            string  // new, user who created the recipe
-	User              User    // new, relationship to User
+	Tags              Tags             `gorm:"many2many:recipe_tags;"`
+	Dietary           DietaryFlags     `gorm:"embedded"`
+	DietaryOverrides  DietaryOverrides `gorm:"embedded;embeddedPrefix:override_"`
+	Allergens         RecipeAllergens
+	SearchTitle       string     // segmented name, see internal/search
+	SearchBody        string     // segmented description, ingredients and steps
+	RatingCount       int64      `gorm:"->"` // kept by the ratings, see internal/rating
+	AverageRating     float64    `gorm:"->"` // new
+	RatingScore       float64    `gorm:"->"` // Bayesian average, computed by the database
+	CommentCount      int64      `gorm:"->"` // kept by the comments, see internal/comment
+	Nutrition         *Nutrition `gorm:"-"`
+	SortKey           string     `gorm:"->;-:migration"` // not a column but the key of the listing order, see pagination.Scope
+	UserID            string     // new, user who created the recipe
+	User              User       // new, relationship to User
+	ForkedFromID      *uint
+	Attribution       RecipeAttributions `gorm:"-"` // the fork chain, nearest first
+}
+
+// Recipe statuses. Only published recipes are listed; unlisted ones open for
+// anyone with the link; drafts and private ones only for their author.
+const (
+	RecipeStatusDraft     = "draft"
+	RecipeStatusPublished = "published"
+	RecipeStatusUnlisted  = "unlisted"
+	RecipeStatusPrivate   = "private"
+)
+
+// VisibleTo tells whether the user may open the recipe. An empty user ID is an
+// anonymous visitor.
+func (recipe FoodRecipe) VisibleTo(userID string) bool {
+	switch recipe.Status {
+	case RecipeStatusPublished, RecipeStatusUnlisted:
+		return true
+	}
+	return userID != "" && recipe.UserID == userID
 }
 
 type FoodRecipeQuery struct {
-	Search string `form:"search"`
-	Page   int    `form:"page" binding:"required,min=1"`  // page number for pagination
-	Limit  int    `form:"limit" binding:"required,min=1"` // number of items per page
+	PageQuery
+	Search           string   `form:"search"`
+	Diets            []string `form:"diet" binding:"omitempty,dive,oneof=vegetarian vegan halal-friendly gluten-free"`
+	ExcludeAllergens []string `form:"excludeAllergen" binding:"omitempty,dive,oneof=peanut shellfish fish-sauce egg dairy"`
+
+	DifficultyID      uint       `form:"difficultyId"`
+	CookingDurationID uint       `form:"cookingDurationId"`
+	MinRating         float64    `form:"minRating" binding:"omitempty,min=0,max=5"`
+	UserID            string     `form:"userId"`                               // author
+	CreatedFrom       *time.Time `form:"createdFrom" time_format:"2006-01-02"` // inclusive
+	CreatedTo         *time.Time `form:"createdTo" time_format:"2006-01-02"`   // inclusive, the whole day
+
+	Sort string `form:"sort" binding:"omitempty,oneof=name newest oldest top-rated most-rated most-favorited relevance"`
+}
+
+// UnitsQuery lets recipe GET endpoints rewrite ingredient amounts in another
+// unit system. Empty keeps the units the author wrote.
+type UnitsQuery struct {
+	Units string `form:"units" binding:"omitempty,oneof=metric us"`
+}
+
+// FormatQuery picks what recipe GET endpoints answer with: the JSON of the
+// API, or the recipe rendered for other applications, see internal/render.
+// Empty leaves the choice to the Accept header.
+type FormatQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=json jsonld markdown cooklang"`
+}
+
+type FoodRecipeScaleQuery struct {
+	Servings int `form:"servings" binding:"required,min=1,max=100"`
 }
 
 func (recipe FoodRecipe) FromRequest(request dto.FoodRecipeRequest, claims Claims) FoodRecipe {
+	ingredients := RecipeIngredients{}.FromRequest(request.Ingredients)
+
+	// Keep the legacy text filled for clients that only read `ingredient`
+	ingredient := request.Ingredient
+	if ingredient == "" && len(ingredients) > 0 {
+		ingredient = ingredients.String()
+	}
+
+	steps := RecipeSteps{}.FromRequest(request.Steps)
+
+	// Overrides the author does not resend are kept
+	overrides := recipe.DietaryOverrides
+	allergens := RecipeAllergens{}.FromOverrides(recipe.Allergens.Overrides())
+	if request.Dietary != nil {
+		overrides = DietaryOverrides{}.FromRequest(*request.Dietary)
+		allergens = RecipeAllergens{}.FromOverrides(request.Dietary.Allergens)
+	}
+
+	instruction := request.Instruction
+	if instruction == "" && len(steps) > 0 {
+		instruction = steps.String()
+	}
+
+	// Publishing goes through its own check, so a request only keeps the
+	// status or takes the recipe out of the listing
+	status := recipe.Status
+	if request.Status != "" {
+		status = request.Status
+	}
+	if status == "" {
+		status = RecipeStatusDraft
+	}
+
 	return FoodRecipe{
+		Model:             recipe.Model,
 		Name:              request.Name,
 		Description:       request.Description,
-		Ingredient:        request.Ingredient,
-		Instruction:       request.Instruction,
+		Ingredient:        ingredient,
+		Ingredients:       ingredients,
+		Instruction:       instruction,
+		Steps:             steps,
 		ImageURL:          request.ImageURL,
+		Servings:          request.Servings,
+		Status:            status,
 		CookingDurationID: request.CookingDurationID,
 		DifficultyID:      request.DifficultyID,
+		Tags:              Tags{}.FromIDs(request.TagIDs),
+		DietaryOverrides:  overrides,
+		Allergens:         allergens,
 		UserID:            claims.ID, // new, set the user ID from claims
 	}
 }
 
+// ToRequest returns the request that would set the recipe as it is now. This
+// is what a revision keeps, and what reverting to it sends back. Lists are
+// never nil, so they are replaced rather than left alone.
+func (recipe FoodRecipe) ToRequest() dto.FoodRecipeRequest {
+	dietary := recipe.DietaryOverrides.ToRequest(recipe.Allergens.Overrides())
+
+	return dto.FoodRecipeRequest{
+		Name:              recipe.Name,
+		Description:       recipe.Description,
+		Ingredient:        recipe.Ingredient,
+		Ingredients:       recipe.Ingredients.ToRequest(),
+		Instruction:       recipe.Instruction,
+		Steps:             recipe.Steps.ToRequest(),
+		ImageURL:          recipe.ImageURL,
+		Servings:          recipe.Servings,
+		CookingDurationID: recipe.CookingDurationID,
+		DifficultyID:      recipe.DifficultyID,
+		TagIDs:            recipe.Tags.IDs(),
+		Dietary:           &dietary,
+	}
+}
+
 func (recipe FoodRecipe) ToResponse() dto.FoodRecipeResponse {
-	return dto.FoodRecipeResponse{
+	response := dto.FoodRecipeResponse{
 		ID:          recipe.ID,
 		Name:        recipe.Name,
 		Description: recipe.Description,
 		Ingredient:  recipe.Ingredient,
+		Ingredients: recipe.Ingredients.ToResponse(),
 		Instruction: recipe.Instruction,
+		Steps:       recipe.Steps.ToResponse(),
 		ImageURL:    recipe.ImageURL,
+		Servings:    recipe.Servings,
+		Status:      recipe.Status,
 		CookingDuration: dto.CookingDurationResponse{
 			ID:   recipe.CookingDuration.ID,
 			Name: recipe.CookingDuration.Name,
@@ -57,12 +188,73 @@ func (recipe FoodRecipe) ToResponse() dto.FoodRecipeResponse {
 			ID:   recipe.Difficulty.ID,
 			Name: recipe.Difficulty.Name,
 		},
+		Tags: recipe.Tags.toResponses(),
+		Dietary: dto.DietaryResponse{
+			Vegetarian:    recipe.Dietary.Vegetarian,
+			Vegan:         recipe.Dietary.Vegan,
+			HalalFriendly: recipe.Dietary.HalalFriendly,
+			GlutenFree:    recipe.Dietary.GlutenFree,
+			Allergens:     recipe.Allergens.Present(),
+		},
 		CreatedAt:     recipe.CreatedAt,
 		UpdatedAt:     recipe.UpdatedAt,
 		AverageRating: recipe.AverageRating, // new
+		RatingCount:   recipe.RatingCount,
+		RatingScore:   recipe.RatingScore,
+		CommentCount:  recipe.CommentCount,
 
 		User: recipe.User.ToResponse(), // new, user who created the recipe
+
+		ForkedFromID: recipe.ForkedFromID,
+		Attribution:  recipe.Attribution.ToResponse(),
 	}
+
+	if recipe.ImageURL != nil {
+		response.Thumbnails = Thumbnails(*recipe.ImageURL)
+	}
+
+	if recipe.Nutrition != nil {
+		nutrition := recipe.Nutrition.ToResponse()
+		response.Nutrition = &nutrition
+	}
+
+	return response
+}
+
+// FoodRecipeFacets counts the recipes matching a query per difficulty and
+// cooking duration.
+type FoodRecipeFacets struct {
+	Difficulties     FacetCounts
+	CookingDurations FacetCounts
+}
+
+func (facets FoodRecipeFacets) ToResponse() dto.FoodRecipeFacetsResponse {
+	return dto.FoodRecipeFacetsResponse{
+		Difficulties:     facets.Difficulties.ToResponse(),
+		CookingDurations: facets.CookingDurations.ToResponse(),
+	}
+}
+
+type FacetCount struct {
+	ID    uint
+	Name  string
+	Count int64
+}
+
+type FacetCounts []FacetCount
+
+func (counts FacetCounts) ToResponse() []dto.FacetCountResponse {
+	var results = make([]dto.FacetCountResponse, 0)
+
+	for _, count := range counts {
+		results = append(results, dto.FacetCountResponse{
+			ID:    count.ID,
+			Name:  count.Name,
+			Count: count.Count,
+		})
+	}
+
+	return results
 }
 
 type FoodRecipes []FoodRecipe
@@ -77,8 +269,10 @@ func (recipes FoodRecipes) ToResponse(
 	}
 
 	return dto.FoodRecipesResponse{
-		// Total:   int64(len(recipes)),
-		Total:   total,
-		Results: result,
+		BaseListResponse: dto.BaseListResponse[[]dto.FoodRecipeResponse]{
+			// Total:   int64(len(recipes)),
+			Total:   total,
+			Results: result,
+		},
 	}
 }
@@ -38,10 +38,66 @@ func TestFoodRecipeFromRequest(t *testing.T) {
 			ImageURL:          &imageURL,
 			CookingDurationID: 1,
 			DifficultyID:      2,
+			Status:            model.RecipeStatusDraft,
+			UserID:            "user-id",
 		}
 
 		assert.Equal(t, expected, recipe, "FoodRecipe should match expected values")
 	})
+
+	t.Run("ShouldKeepStatusWhenNotSent", func(t *testing.T) {
+		published := model.FoodRecipe{Status: model.RecipeStatusPublished}
+
+		recipe := published.FromRequest(dto.FoodRecipeRequest{Name: "Test Recipe"}, claims)
+		assert.Equal(t, model.RecipeStatusPublished, recipe.Status)
+
+		recipe = published.FromRequest(dto.FoodRecipeRequest{Name: "Test Recipe", Status: model.RecipeStatusPrivate}, claims)
+		assert.Equal(t, model.RecipeStatusPrivate, recipe.Status)
+	})
+
+	t.Run("ShouldSetIngredientsInOrder", func(t *testing.T) {
+		quantity := 2.0
+
+		request := dto.FoodRecipeRequest{
+			Name: "Test Recipe",
+			Ingredients: []dto.RecipeIngredientRequest{
+				{Name: "Eggs", Quantity: &quantity},
+				{Name: " Fish sauce ", Unit: "tbsp", Note: "optional"},
+			},
+		}
+
+		recipe := model.FoodRecipe{}.FromRequest(request, claims)
+
+		assert.Equal(t, model.RecipeIngredients{
+			{Name: "Eggs", Quantity: &quantity, Position: 1},
+			{Name: "Fish sauce", Unit: "tbsp", Note: "optional", Position: 2},
+		}, recipe.Ingredients)
+		assert.Equal(t, "2 Eggs\ntbsp Fish sauce (optional)", recipe.Ingredient, "Legacy text should be filled from ingredients")
+	})
+
+	t.Run("ShouldOrderStepsByPosition", func(t *testing.T) {
+		request := dto.FoodRecipeRequest{
+			Steps: []dto.RecipeStepRequest{
+				{Position: 2, Text: "Fry"},
+				{Position: 1, Text: "Beat the eggs"},
+			},
+		}
+
+		recipe := model.FoodRecipe{}.FromRequest(request, claims)
+
+		assert.Equal(t, model.RecipeSteps{
+			{Position: 1, Text: "Beat the eggs"},
+			{Position: 2, Text: "Fry"},
+		}, recipe.Steps)
+		assert.Equal(t, "Beat the eggs\nFry", recipe.Instruction)
+	})
+
+	t.Run("ShouldKeepIngredientsNilWhenNotSent", func(t *testing.T) {
+		recipe := model.FoodRecipe{}.FromRequest(dto.FoodRecipeRequest{Ingredient: "Eggs"}, claims)
+
+		assert.Nil(t, recipe.Ingredients)
+		assert.Equal(t, "Eggs", recipe.Ingredient)
+	})
 }
 
 func TestFoodRecipeToResponse(t *testing.T) {
@@ -79,7 +135,10 @@ func TestFoodRecipeToResponse(t *testing.T) {
 			Name:        "Test Recipe",
 			Description: "Test Description",
 			Ingredient:  "Test Ingredient",
-
+			Ingredients: []dto.RecipeIngredientResponse{},
+			Steps:       []dto.RecipeStepResponse{},
+			Tags:        []dto.TagResponse{},
+			Dietary:     dto.DietaryResponse{Allergens: []string{}},
 			Instruction: "Test Instruction",
 			ImageURL:    &imageURL,
 			CookingDuration: dto.CookingDurationResponse{
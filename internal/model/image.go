@@ -0,0 +1,49 @@
+package model
+
+import (
+	"regexp"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+)
+
+// ImageSize is a thumbnail every uploaded image gets, fitting a square of
+// Pixels on a side.
+type ImageSize struct {
+	Name   string
+	Pixels int
+}
+
+// ImageSizes are the thumbnails of an upload, smallest first.
+var ImageSizes = []ImageSize{
+	{Name: "small", Pixels: 160},
+	{Name: "medium", Pixels: 480},
+	{Name: "large", Pixels: 1024},
+}
+
+// uploadedImage matches the URL of an uploaded image. Its thumbnails sit
+// next to it, named after their size.
+var uploadedImage = regexp.MustCompile(`^(.*/images/[0-9a-f]{32}/)original\.(jpg|png)$`)
+
+// ImageKey is where an upload of the extension is stored, id being random.
+func ImageKey(id string, size string, extension string) string {
+	return "images/" + id + "/" + size + "." + extension
+}
+
+// Thumbnails returns the thumbnails of an uploaded image, nil for an image
+// linked from elsewhere.
+func Thumbnails(imageURL string) *dto.ThumbnailsResponse {
+	match := uploadedImage.FindStringSubmatch(imageURL)
+	if match == nil {
+		return nil
+	}
+
+	url := func(size string) string {
+		return match[1] + size + "." + match[2]
+	}
+
+	return &dto.ThumbnailsResponse{
+		Small:  url("small"),
+		Medium: url("medium"),
+		Large:  url("large"),
+	}
+}
@@ -0,0 +1,26 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThumbnails(t *testing.T) {
+	t.Run("ShouldListThumbnailsOfUpload", func(t *testing.T) {
+		thumbnails := model.Thumbnails("https://cdn.example.com/images/0123456789abcdef0123456789abcdef/original.png")
+
+		assert.Equal(t, &dto.ThumbnailsResponse{
+			Small:  "https://cdn.example.com/images/0123456789abcdef0123456789abcdef/small.png",
+			Medium: "https://cdn.example.com/images/0123456789abcdef0123456789abcdef/medium.png",
+			Large:  "https://cdn.example.com/images/0123456789abcdef0123456789abcdef/large.png",
+		}, thumbnails)
+	})
+
+	t.Run("ShouldIgnoreImageLinkedFromElsewhere", func(t *testing.T) {
+		assert.Nil(t, model.Thumbnails("https://example.com/photos/original.jpg"))
+		assert.Nil(t, model.Thumbnails(""))
+	})
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+)
+
+// DateLayout is how plain dates are written in requests and responses.
+const DateLayout = "2006-01-02"
+
+// Meal slots of a day, in the order they are eaten.
+const (
+	MealSlotBreakfast = "breakfast"
+	MealSlotLunch     = "lunch"
+	MealSlotDinner    = "dinner"
+	MealSlotSnack     = "snack"
+)
+
+var mealSlotOrder = map[string]int{
+	MealSlotBreakfast: 0,
+	MealSlotLunch:     1,
+	MealSlotDinner:    2,
+	MealSlotSnack:     3,
+}
+
+// MealPlanEntry puts a recipe on a user's plan for a meal of a day. Servings
+// overrides the servings of the recipe when set.
+type MealPlanEntry struct {
+	ID           uint `gorm:"primaryKey"`
+	UserID       string
+	Date         time.Time `gorm:"type:date"`
+	Slot         string
+	FoodRecipeID uint
+	FoodRecipe   FoodRecipe `gorm:"-"` // looked up through the recipe repository
+	Servings     *int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (entry MealPlanEntry) ToResponse() dto.MealPlanEntryResponse {
+	servings := entry.FoodRecipe.Servings
+	if entry.Servings != nil {
+		servings = *entry.Servings
+	}
+
+	return dto.MealPlanEntryResponse{
+		ID:       entry.ID,
+		Date:     entry.Date.Format(DateLayout),
+		Slot:     entry.Slot,
+		Servings: servings,
+		Recipe:   entry.FoodRecipe.ToResponse(),
+	}
+}
+
+type MealPlanEntries []MealPlanEntry
+
+// MealPlan is the plan of a user from one date to another, both inclusive.
+type MealPlan struct {
+	From    time.Time
+	To      time.Time
+	Entries MealPlanEntries
+}
+
+// ToResponse lists every day of the plan, empty ones included, with its
+// entries in meal order.
+func (plan MealPlan) ToResponse() dto.MealPlanResponse {
+	entries := append(MealPlanEntries{}, plan.Entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].Date.Equal(entries[j].Date) {
+			return entries[i].Date.Before(entries[j].Date)
+		}
+		return mealSlotOrder[entries[i].Slot] < mealSlotOrder[entries[j].Slot]
+	})
+
+	days := make([]dto.MealPlanDayResponse, 0)
+	for date := plan.From; !date.After(plan.To); date = date.AddDate(0, 0, 1) {
+		day := dto.MealPlanDayResponse{
+			Date:    date.Format(DateLayout),
+			Entries: make([]dto.MealPlanEntryResponse, 0),
+		}
+		for _, entry := range entries {
+			if entry.Date.Format(DateLayout) == day.Date {
+				day.Entries = append(day.Entries, entry.ToResponse())
+			}
+		}
+		days = append(days, day)
+	}
+
+	return dto.MealPlanResponse{
+		From: plan.From.Format(DateLayout),
+		To:   plan.To.Format(DateLayout),
+		Days: days,
+	}
+}
+
+// MealPlanQuery picks the dates of GET /meal-plan: the week holding ?week=,
+// or ?from= to ?to=. Without either it is the current week.
+type MealPlanQuery struct {
+	Week *time.Time `form:"week" time_format:"2006-01-02" time_utc:"1"`
+	From *time.Time `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To   *time.Time `form:"to" time_format:"2006-01-02" time_utc:"1"` // inclusive
+}
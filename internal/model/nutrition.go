@@ -0,0 +1,50 @@
+package model
+
+import (
+	"math"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/nutrition"
+)
+
+// Nutrition is the estimated nutrition of one serving of a recipe. It is
+// computed from the structured ingredients and never stored.
+type Nutrition struct {
+	Servings   int
+	PerServing nutrition.Nutrients
+	Unmatched  []UnmatchedIngredient
+}
+
+// UnmatchedIngredient is an ingredient left out of the estimate, with the
+// reason so the author can fix it.
+type UnmatchedIngredient struct {
+	Name   string
+	Reason string
+}
+
+func (facts Nutrition) ToResponse() dto.NutritionResponse {
+	var unmatched = make([]dto.UnmatchedIngredientResponse, 0)
+
+	for _, ingredient := range facts.Unmatched {
+		unmatched = append(unmatched, dto.UnmatchedIngredientResponse{
+			Name:   ingredient.Name,
+			Reason: ingredient.Reason,
+		})
+	}
+
+	return dto.NutritionResponse{
+		Servings: facts.Servings,
+		PerServing: dto.NutrientsResponse{
+			Calories: roundTenth(facts.PerServing.Calories),
+			Protein:  roundTenth(facts.PerServing.Protein),
+			Fat:      roundTenth(facts.PerServing.Fat),
+			Carbs:    roundTenth(facts.PerServing.Carbs),
+			Sodium:   roundTenth(facts.PerServing.Sodium),
+		},
+		Unmatched: unmatched,
+	}
+}
+
+func roundTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
@@ -0,0 +1,32 @@
+package model
+
+import "github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+
+// PageQuery pages a listing either by page number or by the cursor of a
+// previous response. A cursor wins over the page number, which is kept for
+// older clients.
+type PageQuery struct {
+	Page   int    `form:"page" binding:"omitempty,min=1"`  // page number for pagination
+	Limit  int    `form:"limit" binding:"omitempty,min=1"` // number of items per page
+	Cursor string `form:"cursor"`                          // nextCursor or prevCursor of a previous page
+}
+
+// Size returns the page size, or fallback when no limit was given. A cursor
+// without a limit uses the default size, since it always pages.
+func (query PageQuery) Size(fallback int) int {
+	if query.Limit > 0 {
+		return query.Limit
+	}
+	if query.Cursor != "" {
+		return pagination.DefaultLimit
+	}
+	return fallback
+}
+
+// Offset returns how many rows the page number skips.
+func (query PageQuery) Offset(size int) int {
+	if query.Cursor != "" || query.Page <= 1 {
+		return 0
+	}
+	return (query.Page - 1) * size
+}
@@ -0,0 +1,153 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+)
+
+// PantryItem is an ingredient a user has on hand. Quantity and expiry are
+// optional, most people only note what they have.
+type PantryItem struct {
+	ID         uint `gorm:"primaryKey"`
+	UserID     string
+	Name       string
+	SearchName string // the words of Name, for matching against ingredients
+	Quantity   *float64
+	Unit       string
+	ExpiresOn  *time.Time `gorm:"type:date"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// FromRequest expects a validated request, whose date parses.
+func (item PantryItem) FromRequest(request dto.PantryItemRequest, claims Claims) PantryItem {
+	var expiresOn *time.Time
+	if request.ExpiresOn != "" {
+		date, _ := time.Parse(DateLayout, request.ExpiresOn)
+		expiresOn = &date
+	}
+
+	name := strings.TrimSpace(request.Name)
+
+	return PantryItem{
+		ID:         item.ID,
+		UserID:     claims.ID,
+		Name:       name,
+		SearchName: search.Name(name),
+		Quantity:   request.Quantity,
+		Unit:       strings.TrimSpace(request.Unit),
+		ExpiresOn:  expiresOn,
+		CreatedAt:  item.CreatedAt,
+	}
+}
+
+// ExpiresBy tells whether the item expires on or before date, which counts
+// items that already expired.
+func (item PantryItem) ExpiresBy(date time.Time) bool {
+	return item.ExpiresOn != nil && !item.ExpiresOn.After(date)
+}
+
+// ToResponse flags the item when it expires by expiresBy.
+func (item PantryItem) ToResponse(expiresBy time.Time) dto.PantryItemResponse {
+	response := dto.PantryItemResponse{
+		ID:           item.ID,
+		Name:         item.Name,
+		Quantity:     item.Quantity,
+		Unit:         item.Unit,
+		ExpiringSoon: item.ExpiresBy(expiresBy),
+	}
+
+	if item.ExpiresOn != nil {
+		response.ExpiresOn = item.ExpiresOn.Format(DateLayout)
+	}
+
+	return response
+}
+
+type PantryItems []PantryItem
+
+func (items PantryItems) toResponses(expiresBy time.Time) []dto.PantryItemResponse {
+	var results = make([]dto.PantryItemResponse, 0)
+
+	for _, item := range items {
+		results = append(results, item.ToResponse(expiresBy))
+	}
+
+	return results
+}
+
+func (items PantryItems) ToResponse(expiresBy time.Time) dto.PantryItemsResponse {
+	results := items.toResponses(expiresBy)
+
+	return dto.PantryItemsResponse{
+		Total:   int64(len(results)),
+		Results: results,
+	}
+}
+
+// RecipeCoverage is how much of a recipe's ingredients a pantry covers, a row
+// of the cookable ranking.
+type RecipeCoverage struct {
+	ID          uint // of the recipe
+	Ingredients int
+	Covered     int
+	Coverage    float64 `gorm:"-"` // Covered out of Ingredients
+	SortKey     string  `gorm:"->"`
+}
+
+// IngredientMatch is an ingredient of a recipe with the pantry item covering
+// it, if any.
+type IngredientMatch struct {
+	FoodRecipeID uint
+	Name         string
+	PantryItemID *uint
+}
+
+// CookableRecipe is a recipe ranked by what the pantry covers, with the
+// ingredients still to buy and the pantry items it would use up before they
+// expire.
+type CookableRecipe struct {
+	Recipe   FoodRecipe
+	Coverage RecipeCoverage
+	Missing  []string
+	Expiring PantryItems
+}
+
+type CookableRecipes []CookableRecipe
+
+func (recipes CookableRecipes) ToResponse(total int64, expiresBy time.Time) dto.CookableRecipesResponse {
+	var results = make([]dto.CookableRecipeResponse, 0)
+
+	for _, recipe := range recipes {
+		missing := recipe.Missing
+		if missing == nil {
+			missing = make([]string, 0)
+		}
+
+		results = append(results, dto.CookableRecipeResponse{
+			Recipe:          recipe.Recipe.ToResponse(),
+			IngredientCount: recipe.Coverage.Ingredients,
+			CoveredCount:    recipe.Coverage.Covered,
+			Coverage:        recipe.Coverage.Coverage,
+			Missing:         missing,
+			Expiring:        recipe.Expiring.toResponses(expiresBy),
+		})
+	}
+
+	return dto.CookableRecipesResponse{
+		Total:   total,
+		Results: results,
+	}
+}
+
+// CookableQuery pages GET /food-recipes/cookable. MinCoverage leaves out
+// recipes the pantry covers less of, and ExpiringWithin is how many days
+// ahead an item counts as expiring soon.
+type CookableQuery struct {
+	PageQuery
+	MinCoverage    float64 `form:"minCoverage" binding:"omitempty,gt=0,max=1"`
+	ExpiringWithin int     `form:"expiringWithin" binding:"omitempty,min=1,max=60"`
+}
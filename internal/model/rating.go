@@ -1,10 +1,14 @@
 package model
 
 import (
+	"math"
+
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
 	"gorm.io/gorm"
 )
 
+// Rating is the score a user gives a recipe. A user has one rating per
+// recipe; rating again replaces it.
 type Rating struct {
 	gorm.Model
 	Score        float64
@@ -12,9 +16,10 @@ type Rating struct {
 	UserID       string
 }
 
+// FromRequest rounds the score to the tenth the ratings table keeps.
 func (rating Rating) FromRequest(request dto.RatingRequest) Rating {
 	return Rating{
-		Score: request.Score,
+		Score: math.Round(request.Score*10) / 10,
 	}
 }
 
@@ -22,6 +27,7 @@ func (rating Rating) ToResponse() dto.RatingResponse {
 	return dto.RatingResponse{
 		Score:        rating.Score,
 		FoodRecipeID: rating.FoodRecipeID,
+		UpdatedAt:    rating.UpdatedAt,
 	}
 }
 
@@ -0,0 +1,64 @@
+package model
+
+import "github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+
+// RecipeAttribution is one recipe up the fork chain of another, with its
+// author.
+type RecipeAttribution struct {
+	FoodRecipeID uint
+	Name         string
+	Status       string
+	Deleted      bool
+	UserID       string
+	FirstName    string
+	LastName     string
+	ImageURL     string
+}
+
+// VisibleTo tells whether the user may open the attributed recipe. Deleted
+// recipes are still credited, but cannot be opened.
+func (attribution RecipeAttribution) VisibleTo(userID string) bool {
+	if attribution.Deleted {
+		return false
+	}
+	return FoodRecipe{Status: attribution.Status, UserID: attribution.UserID}.VisibleTo(userID)
+}
+
+// Hide keeps only the author, for recipes the viewer may not open.
+func (attribution RecipeAttribution) Hide() RecipeAttribution {
+	return RecipeAttribution{
+		UserID:    attribution.UserID,
+		FirstName: attribution.FirstName,
+		LastName:  attribution.LastName,
+		ImageURL:  attribution.ImageURL,
+	}
+}
+
+func (attribution RecipeAttribution) ToResponse() dto.RecipeAttributionResponse {
+	return dto.RecipeAttributionResponse{
+		ID:   attribution.FoodRecipeID,
+		Name: attribution.Name,
+		User: dto.UserResponse{
+			ID:        attribution.UserID,
+			FirstName: attribution.FirstName,
+			LastName:  attribution.LastName,
+			ImageURL:  attribution.ImageURL,
+		},
+	}
+}
+
+type RecipeAttributions []RecipeAttribution
+
+// ToResponse keeps nil as nil, so recipes that are not forks leave the field
+// out.
+func (attributions RecipeAttributions) ToResponse() []dto.RecipeAttributionResponse {
+	if attributions == nil {
+		return nil
+	}
+
+	results := make([]dto.RecipeAttributionResponse, 0, len(attributions))
+	for _, attribution := range attributions {
+		results = append(results, attribution.ToResponse())
+	}
+	return results
+}
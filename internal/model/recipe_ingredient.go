@@ -0,0 +1,105 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"gorm.io/gorm"
+)
+
+type RecipeIngredient struct {
+	gorm.Model
+	FoodRecipeID uint
+	Name         string
+	Quantity     *float64
+	Unit         string
+	Note         string
+	Position     int
+	SearchName   string // the words of Name, for matching against pantries
+}
+
+func (ingredient RecipeIngredient) ToResponse() dto.RecipeIngredientResponse {
+	return dto.RecipeIngredientResponse{
+		ID:       ingredient.ID,
+		Name:     ingredient.Name,
+		Quantity: ingredient.Quantity,
+		Unit:     ingredient.Unit,
+		Note:     ingredient.Note,
+		Position: ingredient.Position,
+	}
+}
+
+// String renders the ingredient the way it would be written in the legacy
+// free-text column, e.g. "2 tbsp fish sauce (optional)".
+func (ingredient RecipeIngredient) String() string {
+	var parts []string
+	if ingredient.Quantity != nil {
+		parts = append(parts, strconv.FormatFloat(*ingredient.Quantity, 'f', -1, 64))
+	}
+	if ingredient.Unit != "" {
+		parts = append(parts, ingredient.Unit)
+	}
+	parts = append(parts, ingredient.Name)
+
+	text := strings.Join(parts, " ")
+	if ingredient.Note != "" {
+		text += " (" + ingredient.Note + ")"
+	}
+	return text
+}
+
+type RecipeIngredients []RecipeIngredient
+
+// FromRequest keeps a nil request as nil so callers can tell "not sent" apart
+// from "cleared".
+func (ingredients RecipeIngredients) FromRequest(requests []dto.RecipeIngredientRequest) RecipeIngredients {
+	if requests == nil {
+		return nil
+	}
+
+	results := make(RecipeIngredients, 0, len(requests))
+	for index, request := range requests {
+		results = append(results, RecipeIngredient{
+			Name:     strings.TrimSpace(request.Name),
+			Quantity: request.Quantity,
+			Unit:     strings.TrimSpace(request.Unit),
+			Note:     strings.TrimSpace(request.Note),
+			Position: index + 1,
+		})
+	}
+	return results
+}
+
+// ToRequest returns an empty list rather than nil, so sending it back clears
+// the ingredients.
+func (ingredients RecipeIngredients) ToRequest() []dto.RecipeIngredientRequest {
+	results := make([]dto.RecipeIngredientRequest, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		results = append(results, dto.RecipeIngredientRequest{
+			Name:     ingredient.Name,
+			Quantity: ingredient.Quantity,
+			Unit:     ingredient.Unit,
+			Note:     ingredient.Note,
+		})
+	}
+	return results
+}
+
+func (ingredients RecipeIngredients) ToResponse() []dto.RecipeIngredientResponse {
+	var results = make([]dto.RecipeIngredientResponse, 0)
+
+	for _, ingredient := range ingredients {
+		results = append(results, ingredient.ToResponse())
+	}
+
+	return results
+}
+
+func (ingredients RecipeIngredients) String() string {
+	lines := make([]string, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		lines = append(lines, ingredient.String())
+	}
+	return strings.Join(lines, "\n")
+}
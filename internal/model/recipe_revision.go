@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+)
+
+// RecipeRevision is an immutable snapshot of a recipe, kept after every change
+// to it. Revisions are numbered from 1 per recipe.
+type RecipeRevision struct {
+	ID           uint `gorm:"primarykey"`
+	FoodRecipeID uint
+	Number       int
+	UserID       string // editor
+	User         User
+	Snapshot     dto.FoodRecipeRequest `gorm:"serializer:json"`
+	CreatedAt    time.Time
+}
+
+// ToResponse leaves the snapshot out unless withSnapshot is set, so listings
+// stay small.
+func (revision RecipeRevision) ToResponse(withSnapshot bool) dto.RecipeRevisionResponse {
+	response := dto.RecipeRevisionResponse{
+		Number:    revision.Number,
+		Editor:    revision.User.ToResponse(),
+		CreatedAt: revision.CreatedAt,
+	}
+
+	if withSnapshot {
+		snapshot := revision.Snapshot
+		response.Recipe = &snapshot
+	}
+
+	return response
+}
+
+type RecipeRevisions []RecipeRevision
+
+func (revisions RecipeRevisions) ToResponse() dto.RecipeRevisionsResponse {
+	var results = make([]dto.RecipeRevisionResponse, 0)
+
+	for _, revision := range revisions {
+		results = append(results, revision.ToResponse(false))
+	}
+
+	return dto.RecipeRevisionsResponse{
+		Total:   int64(len(results)),
+		Results: results,
+	}
+}
+
+// FieldChange is one field that differs between two revisions. Field is the
+// JSON path of the field in the recipe request, such as "ingredients[1].unit".
+// From or To is nil when the field only exists on one side.
+type FieldChange struct {
+	Field string
+	From  any
+	To    any
+}
+
+type RevisionDiff struct {
+	From    int
+	To      int
+	Changes []FieldChange
+}
+
+type RevisionDiffQuery struct {
+	From int `form:"from" binding:"required,min=1"`
+	To   int `form:"to" binding:"required,min=1"`
+}
+
+func (diff RevisionDiff) ToResponse() dto.RevisionDiffResponse {
+	var changes = make([]dto.FieldChangeResponse, 0)
+
+	for _, change := range diff.Changes {
+		changes = append(changes, dto.FieldChangeResponse{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+
+	return dto.RevisionDiffResponse{
+		From:    diff.From,
+		To:      diff.To,
+		Changes: changes,
+	}
+}
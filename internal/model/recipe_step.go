@@ -0,0 +1,87 @@
+package model
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"gorm.io/gorm"
+)
+
+type RecipeStep struct {
+	gorm.Model
+	FoodRecipeID    uint
+	Position        int
+	Text            string
+	DurationSeconds *int
+	ImageURL        *string
+}
+
+func (step RecipeStep) ToResponse() dto.RecipeStepResponse {
+	return dto.RecipeStepResponse{
+		ID:              step.ID,
+		Position:        step.Position,
+		Text:            step.Text,
+		DurationSeconds: step.DurationSeconds,
+		ImageURL:        step.ImageURL,
+	}
+}
+
+type RecipeSteps []RecipeStep
+
+// FromRequest orders steps by their position. A nil request stays nil so
+// callers can tell "not sent" apart from "cleared".
+func (steps RecipeSteps) FromRequest(requests []dto.RecipeStepRequest) RecipeSteps {
+	if requests == nil {
+		return nil
+	}
+
+	results := make(RecipeSteps, 0, len(requests))
+	for _, request := range requests {
+		results = append(results, RecipeStep{
+			Position:        request.Position,
+			Text:            strings.TrimSpace(request.Text),
+			DurationSeconds: request.DurationSeconds,
+			ImageURL:        request.ImageURL,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Position < results[j].Position
+	})
+
+	return results
+}
+
+// ToRequest returns an empty list rather than nil, so sending it back clears
+// the steps.
+func (steps RecipeSteps) ToRequest() []dto.RecipeStepRequest {
+	results := make([]dto.RecipeStepRequest, 0, len(steps))
+	for _, step := range steps {
+		results = append(results, dto.RecipeStepRequest{
+			Position:        step.Position,
+			Text:            step.Text,
+			DurationSeconds: step.DurationSeconds,
+			ImageURL:        step.ImageURL,
+		})
+	}
+	return results
+}
+
+func (steps RecipeSteps) ToResponse() []dto.RecipeStepResponse {
+	var results = make([]dto.RecipeStepResponse, 0)
+
+	for _, step := range steps {
+		results = append(results, step.ToResponse())
+	}
+
+	return results
+}
+
+func (steps RecipeSteps) String() string {
+	lines := make([]string, 0, len(steps))
+	for _, step := range steps {
+		lines = append(lines, step.Text)
+	}
+	return strings.Join(lines, "\n")
+}
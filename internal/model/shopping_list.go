@@ -0,0 +1,83 @@
+package model
+
+import (
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"gorm.io/gorm"
+)
+
+// ShoppingList is a list of groceries a user generated out of recipes or a
+// meal plan. Its items are a copy, so editing the recipes later does not
+// change a list.
+type ShoppingList struct {
+	gorm.Model
+	UserID string
+	Name   string
+	Items  ShoppingListItems
+}
+
+// ToResponse groups the items by aisle. Items are kept in aisle order, so
+// each aisle is a run of consecutive items. Lists read without their items
+// have no aisles.
+func (list ShoppingList) ToResponse() dto.ShoppingListResponse {
+	response := dto.ShoppingListResponse{
+		ID:        list.ID,
+		Name:      list.Name,
+		CreatedAt: list.CreatedAt,
+		UpdatedAt: list.UpdatedAt,
+	}
+
+	for _, item := range list.Items {
+		last := len(response.Aisles) - 1
+		if last < 0 || response.Aisles[last].Aisle != item.Aisle {
+			response.Aisles = append(response.Aisles, dto.ShoppingListAisleResponse{Aisle: item.Aisle})
+			last++
+		}
+		response.Aisles[last].Items = append(response.Aisles[last].Items, item.ToResponse())
+	}
+
+	return response
+}
+
+type ShoppingLists []ShoppingList
+
+func (lists ShoppingLists) ToResponse(total int64) dto.ShoppingListsResponse {
+	var results = make([]dto.ShoppingListResponse, 0)
+
+	for _, list := range lists {
+		results = append(results, list.ToResponse())
+	}
+
+	return dto.ShoppingListsResponse{
+		Total:   total,
+		Results: results,
+	}
+}
+
+// ShoppingListItem is an ingredient to buy, merged over every recipe of the
+// list that uses it.
+type ShoppingListItem struct {
+	ID             uint `gorm:"primaryKey"`
+	ShoppingListID uint
+	Name           string
+	Quantity       *float64
+	Unit           string
+	Aisle          string
+	Checked        bool
+	Position       int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (item ShoppingListItem) ToResponse() dto.ShoppingListItemResponse {
+	return dto.ShoppingListItemResponse{
+		ID:       item.ID,
+		Name:     item.Name,
+		Quantity: item.Quantity,
+		Unit:     item.Unit,
+		Checked:  item.Checked,
+	}
+}
+
+type ShoppingListItems []ShoppingListItem
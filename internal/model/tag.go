@@ -0,0 +1,145 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"gorm.io/gorm"
+)
+
+// TagGroup is an admin-managed facet recipes are classified by, such as
+// cuisine, meal type, main ingredient or region.
+type TagGroup struct {
+	gorm.Model
+	Name string
+	Slug string
+	Tags Tags
+}
+
+func (group TagGroup) FromRequest(request dto.TagGroupRequest) TagGroup {
+	return TagGroup{
+		Model: group.Model,
+		Name:  strings.TrimSpace(request.Name),
+		Slug:  strings.TrimSpace(request.Slug),
+	}
+}
+
+func (group TagGroup) ToResponse() dto.TagGroupResponse {
+	return dto.TagGroupResponse{
+		ID:   group.ID,
+		Name: group.Name,
+		Slug: group.Slug,
+	}
+}
+
+type TagGroups []TagGroup
+
+func (groups TagGroups) ToResponse() dto.TagGroupsResponse {
+	var results = make([]dto.TagGroupResponse, 0)
+
+	for _, group := range groups {
+		response := group.ToResponse()
+		response.Tags = group.Tags.toResponses()
+		results = append(results, response)
+	}
+
+	return dto.TagGroupsResponse{
+		Total:   int64(len(results)),
+		Results: results,
+	}
+}
+
+type Tag struct {
+	gorm.Model
+	TagGroupID uint
+	TagGroup   TagGroup
+	Name       string
+	// RecipeCount is only filled when tags are listed with their usage. It is
+	// not a column, so a query joining other tables has to select tags.*
+	// itself.
+	RecipeCount int64 `gorm:"->;-:migration"`
+}
+
+func (tag Tag) FromRequest(request dto.TagRequest) Tag {
+	return Tag{
+		Model:      tag.Model,
+		TagGroupID: request.TagGroupID,
+		Name:       strings.TrimSpace(request.Name),
+	}
+}
+
+func (tag Tag) ToResponse() dto.TagResponse {
+	response := dto.TagResponse{
+		ID:   tag.ID,
+		Name: tag.Name,
+	}
+
+	if tag.TagGroup.ID != 0 {
+		group := tag.TagGroup.ToResponse()
+		response.Group = &group
+	}
+
+	return response
+}
+
+type Tags []Tag
+
+// FromIDs references existing tags by ID. A nil list stays nil so callers can
+// tell "not sent" apart from "cleared".
+func (tags Tags) FromIDs(ids []uint) Tags {
+	if ids == nil {
+		return nil
+	}
+
+	results := make(Tags, 0, len(ids))
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		results = append(results, Tag{Model: gorm.Model{ID: id}})
+	}
+	return results
+}
+
+func (tags Tags) IDs() []uint {
+	ids := make([]uint, 0, len(tags))
+	for _, tag := range tags {
+		ids = append(ids, tag.ID)
+	}
+	return ids
+}
+
+// toResponses renders tags attached to something else, without counts.
+func (tags Tags) toResponses() []dto.TagResponse {
+	var results = make([]dto.TagResponse, 0)
+
+	for _, tag := range tags {
+		results = append(results, tag.ToResponse())
+	}
+
+	return results
+}
+
+// ToResponse renders the tag listing, which is the only place recipe counts
+// are shown.
+func (tags Tags) ToResponse() dto.TagsResponse {
+	var results = make([]dto.TagResponse, 0)
+
+	for _, tag := range tags {
+		response := tag.ToResponse()
+		response.RecipeCount = &tag.RecipeCount
+		results = append(results, response)
+	}
+
+	return dto.TagsResponse{
+		Total:   int64(len(results)),
+		Results: results,
+	}
+}
+
+// TagQuery filters GET /tags by group slug, e.g. ?group=region.
+type TagQuery struct {
+	Group string `form:"group"`
+}
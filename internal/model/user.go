@@ -24,10 +24,11 @@ func (user User) FromClaims(claims Claims) User {
 
 func (user User) ToResponse() dto.UserResponse {
 	return dto.UserResponse{
-		ID:        user.ID,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		ImageURL:  user.ImageURL,
+		ID:         user.ID,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		ImageURL:   user.ImageURL,
+		Thumbnails: Thumbnails(user.ImageURL),
 	}
 }
 
@@ -0,0 +1,139 @@
+// Package nutrition estimates nutrients from a bundled reference table of
+// common Thai and international ingredients.
+package nutrition
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"strconv"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+)
+
+//go:embed nutrients.csv
+var nutrientsCSV []byte
+
+// Nutrients are absolute amounts: kcal, grams of protein, fat and carbs, and
+// milligrams of sodium.
+type Nutrients struct {
+	Calories float64
+	Protein  float64
+	Fat      float64
+	Carbs    float64
+	Sodium   float64
+}
+
+func (nutrients Nutrients) Add(other Nutrients) Nutrients {
+	return Nutrients{
+		Calories: nutrients.Calories + other.Calories,
+		Protein:  nutrients.Protein + other.Protein,
+		Fat:      nutrients.Fat + other.Fat,
+		Carbs:    nutrients.Carbs + other.Carbs,
+		Sodium:   nutrients.Sodium + other.Sodium,
+	}
+}
+
+func (nutrients Nutrients) Scale(factor float64) Nutrients {
+	return Nutrients{
+		Calories: nutrients.Calories * factor,
+		Protein:  nutrients.Protein * factor,
+		Fat:      nutrients.Fat * factor,
+		Carbs:    nutrients.Carbs * factor,
+		Sodium:   nutrients.Sodium * factor,
+	}
+}
+
+type Entry struct {
+	Name    string
+	Aliases []string
+	// Per100g holds the nutrients in 100 grams of the ingredient
+	Per100g Nutrients
+	// PieceGrams is the weight of one piece, e.g. one egg, when known
+	PieceGrams float64
+
+	// keys holds the name and aliases split into words
+	keys [][]string
+}
+
+// ForGrams returns the nutrients in the given weight of the ingredient.
+func (entry Entry) ForGrams(grams float64) Nutrients {
+	return entry.Per100g.Scale(grams / 100)
+}
+
+var entries = mustLoad(nutrientsCSV)
+
+// Match finds the reference entry for an ingredient name. The name or alias
+// has to name what the ingredient is, see search.IsHead, and the one of most
+// words wins, so "chicken breast" is not chicken and "rice vinegar" is not
+// rice. Words have to match whole, so "น้ำปลา" is fish sauce rather than
+// water.
+func Match(ingredient string) (Entry, bool) {
+	words := search.Tokenize(ingredient)
+	if len(words) == 0 {
+		return Entry{}, false
+	}
+
+	var (
+		best      Entry
+		bestWords int
+	)
+	for _, entry := range entries {
+		for _, key := range entry.keys {
+			if len(key) > bestWords && search.IsHead(words, key) {
+				best, bestWords = entry, len(key)
+			}
+		}
+	}
+
+	return best, bestWords > 0
+}
+
+func mustLoad(data []byte) []Entry {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		panic("nutrition: read nutrients.csv: " + err.Error())
+	}
+
+	results := make([]Entry, 0, len(records))
+	for _, record := range records[1:] {
+		var aliases []string
+		if record[1] != "" {
+			aliases = strings.Split(strings.ToLower(record[1]), "|")
+		}
+
+		name := strings.ToLower(record[0])
+		keys := [][]string{search.Tokenize(name)}
+		for _, alias := range aliases {
+			keys = append(keys, search.Tokenize(alias))
+		}
+
+		results = append(results, Entry{
+			Name:    name,
+			Aliases: aliases,
+			keys:    keys,
+			Per100g: Nutrients{
+				Calories: mustFloat(record[2]),
+				Protein:  mustFloat(record[3]),
+				Fat:      mustFloat(record[4]),
+				Carbs:    mustFloat(record[5]),
+				Sodium:   mustFloat(record[6]),
+			},
+			PieceGrams: mustFloat(record[7]),
+		})
+	}
+	return results
+}
+
+func mustFloat(value string) float64 {
+	if value == "" {
+		return 0
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		panic("nutrition: parse nutrients.csv: " + err.Error())
+	}
+	return number
+}
@@ -0,0 +1,43 @@
+package nutrition_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/nutrition"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	cases := map[string]struct {
+		ingredient string
+		expected   string
+		found      bool
+	}{
+		"ShouldMatchEnglishName":        {ingredient: "Chicken Breast", expected: "chicken breast", found: true},
+		"ShouldMatchThaiAlias":          {ingredient: "กระเทียมสับ", expected: "garlic", found: true},
+		"ShouldPreferLongestThaiAlias":  {ingredient: "น้ำปลา", expected: "fish sauce", found: true},
+		"ShouldPreferLongestName":       {ingredient: "rice flour", expected: "rice flour", found: true},
+		"ShouldNotTreatEggplantAsEgg":   {ingredient: "eggplant", expected: "eggplant", found: true},
+		"ShouldMatchStockOverChicken":   {ingredient: "chicken stock", expected: "stock", found: true},
+		"ShouldNotReadButterInWord":     {ingredient: "buttermilk", found: false},
+		"ShouldNotMatchModifier":        {ingredient: "rice vinegar", found: false},
+		"ShouldNotReadWaterInThaiWord":  {ingredient: "น้ำมะพร้าว", found: false},
+		"ShouldNotMatchUnknown":         {ingredient: "dragon fruit", found: false},
+		"ShouldNotMatchEmptyIngredient": {ingredient: " ", found: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			entry, found := nutrition.Match(tc.ingredient)
+
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, entry.Name)
+		})
+	}
+}
+
+func TestForGrams(t *testing.T) {
+	entry, _ := nutrition.Match("sugar")
+
+	assert.Equal(t, nutrition.Nutrients{Calories: 193.5, Carbs: 50, Sodium: 0.5}, entry.ForGrams(50))
+}
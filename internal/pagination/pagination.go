@@ -0,0 +1,171 @@
+// Package pagination pages listings by keyset: a page starts right after the
+// sort key and ID of the last row the client saw, so deep pages stay cheap and
+// rows do not shift when new ones are added.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultLimit is the page size when a cursor is given without a limit.
+const DefaultLimit = 20
+
+// Cursor is a position in a listing. Clients only see it encoded.
+type Cursor struct {
+	Sort     string `json:"s,omitempty"`
+	Key      string `json:"k,omitempty"`
+	ID       uint   `json:"id"`
+	Backward bool   `json:"b,omitempty"`
+}
+
+func (cursor Cursor) Encode() string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode reads a cursor from a query. An empty text means the first page and
+// returns nil.
+func Decode(text string) (*Cursor, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(text)
+	if err != nil {
+		return nil, errors.Wrap(global.ErrorInvalidCursor, err.Error())
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, errors.Wrap(global.ErrorInvalidCursor, err.Error())
+	}
+
+	return &cursor, nil
+}
+
+// Cursors point at the pages around the current one. They are empty when
+// there is no such page.
+type Cursors struct {
+	Next string
+	Prev string
+}
+
+// Order is the keyset a listing is sorted by: an optional SQL expression and
+// then the table's ID in the same direction, so ties never shift between
+// pages. Type is the SQL type the expression is compared as, since the cursor
+// carries the key as text.
+type Order struct {
+	Table string
+	SQL   string
+	Vars  []any
+	Type  string
+	Desc  bool
+}
+
+// KeyColumn is the column the expression is selected as, for Position to read
+// back from the last row.
+const KeyColumn = "sort_key"
+
+func direction(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// Scope orders the rows, starts after the cursor when there is one and
+// otherwise skips offset rows. It fetches one row over the limit, which
+// Paginate uses to tell whether another page follows. A limit of zero or less
+// fetches every row.
+func Scope(order Order, cursor *Cursor, limit int, offset int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		id := order.Table + ".id"
+
+		desc := order.Desc
+		if cursor != nil && cursor.Backward {
+			desc = !desc
+		}
+
+		if order.SQL != "" {
+			db = db.Select(order.Table+".*, CAST("+order.SQL+" AS TEXT) AS "+KeyColumn, order.Vars...)
+		}
+
+		if cursor != nil {
+			operator := ">"
+			if desc {
+				operator = "<"
+			}
+
+			if order.SQL == "" {
+				db = db.Where(id+" "+operator+" ?", cursor.ID)
+			} else {
+				vars := append(append([]any{}, order.Vars...), cursor.Key, cursor.ID)
+				db = db.Where("("+order.SQL+", "+id+") "+operator+" (CAST(? AS "+order.Type+"), ?)", vars...)
+			}
+		} else if offset > 0 {
+			db = db.Offset(offset)
+		}
+
+		if limit > 0 {
+			db = db.Limit(limit + 1)
+		}
+
+		// A single expression, since gorm drops an expression once plain
+		// columns are merged into the same ORDER BY
+		sql := id + " " + direction(desc)
+		if order.SQL != "" {
+			sql = order.SQL + " " + direction(desc) + ", " + sql
+		}
+		return db.Order(clause.OrderBy{Expression: clause.Expr{SQL: sql, Vars: order.Vars}})
+	}
+}
+
+// Paginate cuts rows fetched by Scope down to the page, puts rows fetched
+// backward in order again and returns the cursors around the page. skipped
+// tells whether the page was reached by an offset. position returns the
+// cursor of a row.
+func Paginate[T any](rows []T, limit int, cursor *Cursor, skipped bool, position func(T) Cursor) ([]T, Cursors) {
+	if limit <= 0 {
+		return rows, Cursors{}
+	}
+
+	more := len(rows) > limit
+	if more {
+		rows = rows[:limit]
+	}
+
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		for left, right := 0, len(rows)-1; left < right; left, right = left+1, right-1 {
+			rows[left], rows[right] = rows[right], rows[left]
+		}
+	}
+
+	var cursors Cursors
+	if len(rows) == 0 {
+		return rows, cursors
+	}
+
+	hasNext, hasPrev := more, cursor != nil || skipped
+	if backward {
+		hasNext, hasPrev = true, more
+	}
+
+	if hasNext {
+		cursors.Next = position(rows[len(rows)-1]).Encode()
+	}
+
+	if hasPrev {
+		prev := position(rows[0])
+		prev.Backward = true
+		cursors.Prev = prev.Encode()
+	}
+
+	return rows, cursors
+}
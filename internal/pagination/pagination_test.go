@@ -0,0 +1,76 @@
+package pagination_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("ShouldReadEncodedCursor", func(t *testing.T) {
+		cursor := pagination.Cursor{Sort: "newest", Key: "2026-10-18 10:00:00", ID: 12, Backward: true}
+
+		decoded, err := pagination.Decode(cursor.Encode())
+		assert.NoError(t, err)
+		assert.Equal(t, &cursor, decoded)
+	})
+
+	t.Run("ShouldReturnNilWhenEmpty", func(t *testing.T) {
+		decoded, err := pagination.Decode("")
+		assert.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("ShouldErrorWhenMalformed", func(t *testing.T) {
+		_, err := pagination.Decode("not a cursor")
+		assert.ErrorIs(t, err, global.ErrorInvalidCursor)
+
+		_, err = pagination.Decode("bm90IGpzb24")
+		assert.ErrorIs(t, err, global.ErrorInvalidCursor)
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	position := func(id uint) pagination.Cursor {
+		return pagination.Cursor{ID: id}
+	}
+	cursorOf := func(id uint, backward bool) string {
+		return pagination.Cursor{ID: id, Backward: backward}.Encode()
+	}
+
+	t.Run("ShouldCutFirstPage", func(t *testing.T) {
+		rows, cursors := pagination.Paginate([]uint{1, 2, 3}, 2, nil, false, position)
+
+		assert.Equal(t, []uint{1, 2}, rows)
+		assert.Equal(t, pagination.Cursors{Next: cursorOf(2, false)}, cursors)
+	})
+
+	t.Run("ShouldPointBackAfterCursor", func(t *testing.T) {
+		rows, cursors := pagination.Paginate([]uint{3, 4}, 2, &pagination.Cursor{ID: 2}, false, position)
+
+		assert.Equal(t, []uint{3, 4}, rows)
+		assert.Equal(t, pagination.Cursors{Prev: cursorOf(3, true)}, cursors)
+	})
+
+	t.Run("ShouldRestoreOrderWhenBackward", func(t *testing.T) {
+		rows, cursors := pagination.Paginate([]uint{4, 3, 2}, 2, &pagination.Cursor{ID: 5, Backward: true}, false, position)
+
+		assert.Equal(t, []uint{3, 4}, rows)
+		assert.Equal(t, pagination.Cursors{Next: cursorOf(4, false), Prev: cursorOf(3, true)}, cursors)
+	})
+
+	t.Run("ShouldPointBackAfterOffset", func(t *testing.T) {
+		_, cursors := pagination.Paginate([]uint{3}, 2, nil, true, position)
+
+		assert.Equal(t, pagination.Cursors{Prev: cursorOf(3, true)}, cursors)
+	})
+
+	t.Run("ShouldKeepEveryRowWithoutLimit", func(t *testing.T) {
+		rows, cursors := pagination.Paginate([]uint{1, 2, 3}, 0, nil, false, position)
+
+		assert.Equal(t, []uint{1, 2, 3}, rows)
+		assert.Equal(t, pagination.Cursors{}, cursors)
+	})
+}
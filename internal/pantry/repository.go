@@ -0,0 +1,169 @@
+package pantry
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Get(userID string) (model.PantryItems, error)
+	GetByID(id string) (model.PantryItem, error)
+	GetByName(userID string, name string) (model.PantryItem, error)
+	Create(item *model.PantryItem) error
+	Update(item *model.PantryItem) error
+	Delete(id uint) error
+	GetCookable(userID string, query model.CookableQuery) ([]model.RecipeCoverage, pagination.Cursors, error)
+	CountCookable(userID string, query model.CookableQuery) (int64, error)
+	GetMatches(userID string, recipeIDs []uint) ([]model.IngredientMatch, error)
+	GetAll() (model.PantryItems, error)
+	UpdateSearchName(item *model.PantryItem) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// Get lists the pantry of a user, what expires first on top.
+func (repo Repository) Get(userID string) (model.PantryItems, error) {
+	var items = make(model.PantryItems, 0)
+	err := repo.DB.Where("user_id = ?", userID).
+		Order("expires_on ASC NULLS LAST, LOWER(name) ASC").
+		Find(&items).Error
+	return items, err
+}
+
+func (repo Repository) GetByID(id string) (model.PantryItem, error) {
+	var item model.PantryItem
+	err := repo.DB.First(&item, "id = ?", id).Error
+	return item, err
+}
+
+// GetByName finds an item of a user by name, ignoring case.
+func (repo Repository) GetByName(userID string, name string) (model.PantryItem, error) {
+	var item model.PantryItem
+	err := repo.DB.First(&item, "user_id = ? AND LOWER(name) = LOWER(?)", userID, name).Error
+	return item, err
+}
+
+func (repo Repository) Create(item *model.PantryItem) error {
+	return repo.DB.Create(item).Error
+}
+
+func (repo Repository) Update(item *model.PantryItem) error {
+	return repo.DB.Save(item).Error
+}
+
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Delete(&model.PantryItem{}, id).Error
+}
+
+// GetAll returns the items of every pantry.
+func (repo Repository) GetAll() (model.PantryItems, error) {
+	var items = make(model.PantryItems, 0)
+	err := repo.DB.Order("id ASC").Find(&items).Error
+	return items, err
+}
+
+// UpdateSearchName writes only the words of the name, leaving updated_at.
+func (repo Repository) UpdateSearchName(item *model.PantryItem) error {
+	return repo.DB.Model(item).UpdateColumn("search_name", item.SearchName).Error
+}
+
+// covers is when a pantry item covers an ingredient: the words of one name
+// are all in the other, so "eggs" covers "egg" and "chicken" covers "chicken
+// thigh", while "egg" does not cover "eggplant". The words come from
+// search.Name, which splits Thai, so "น้ำ" does not cover "น้ำปลา" either.
+const covers = "(pantry_items.search_name <> '' AND recipe_ingredients.search_name <> ''" +
+	" AND (STRING_TO_ARRAY(pantry_items.search_name, ' ') <@ STRING_TO_ARRAY(recipe_ingredients.search_name, ' ')" +
+	" OR STRING_TO_ARRAY(recipe_ingredients.search_name, ' ') <@ STRING_TO_ARRAY(pantry_items.search_name, ' ')))"
+
+// coverage counts, for every recipe the user may cook from, its structured
+// ingredients and how many of them the pantry covers. Recipes written as free
+// text only have no ingredients to count and are left out. The counting is
+// done in one grouped pass over the ingredients, as a table to rank from.
+func (repo Repository) coverage(userID string, query model.CookableQuery) *gorm.DB {
+	counts := repo.DB.Table("food_recipes").
+		Select(
+			"food_recipes.id, COUNT(*) AS ingredients, "+
+				"COUNT(*) FILTER (WHERE EXISTS (SELECT 1 FROM pantry_items WHERE pantry_items.user_id = ? AND "+covers+")) AS covered",
+			userID,
+		).
+		Joins("JOIN recipe_ingredients ON recipe_ingredients.food_recipe_id = food_recipes.id AND recipe_ingredients.deleted_at IS NULL").
+		Where("food_recipes.deleted_at IS NULL").
+		Where("(food_recipes.status = ? OR food_recipes.user_id = ?)", model.RecipeStatusPublished, userID).
+		Group("food_recipes.id")
+
+	db := repo.DB.Table("(?) AS cookable", counts).Where("cookable.covered > 0")
+	if query.MinCoverage > 0 {
+		db = db.Where(coverageSQL+" >= ?", query.MinCoverage)
+	}
+	return db
+}
+
+// coverageSQL is the share of the ingredients covered. NUMERIC keeps the
+// division exact, so it reads back from a cursor as the same key.
+const coverageSQL = "CAST(cookable.covered AS NUMERIC) / cookable.ingredients"
+
+// GetCookable ranks the recipes the pantry covers most of first.
+func (repo Repository) GetCookable(userID string, query model.CookableQuery) ([]model.RecipeCoverage, pagination.Cursors, error) {
+	var coverages = make([]model.RecipeCoverage, 0)
+
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	limit := query.Size(pagination.DefaultLimit)
+	order := pagination.Order{Table: "cookable", SQL: coverageSQL, Type: "NUMERIC", Desc: true}
+
+	err = repo.coverage(userID, query).
+		Scopes(pagination.Scope(order, cursor, limit, query.Offset(limit))).
+		Find(&coverages).Error
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	coverages, cursors := pagination.Paginate(coverages, limit, cursor, query.Offset(limit) > 0, func(coverage model.RecipeCoverage) pagination.Cursor {
+		return pagination.Cursor{Key: coverage.SortKey, ID: coverage.ID}
+	})
+
+	for index := range coverages {
+		coverages[index].Coverage = float64(coverages[index].Covered) / float64(coverages[index].Ingredients)
+	}
+
+	return coverages, cursors, nil
+}
+
+func (repo Repository) CountCookable(userID string, query model.CookableQuery) (int64, error) {
+	var count int64
+	err := repo.coverage(userID, query).Count(&count).Error
+	return count, err
+}
+
+// GetMatches returns the ingredients of the recipes in order, each with the
+// pantry item covering it. When several do, the one expiring first is used.
+func (repo Repository) GetMatches(userID string, recipeIDs []uint) ([]model.IngredientMatch, error) {
+	var matches = make([]model.IngredientMatch, 0)
+	if len(recipeIDs) == 0 {
+		return matches, nil
+	}
+
+	err := repo.DB.Table("recipe_ingredients").
+		Select("recipe_ingredients.food_recipe_id, recipe_ingredients.name, pantry.id AS pantry_item_id").
+		Joins(
+			"LEFT JOIN LATERAL (SELECT pantry_items.id FROM pantry_items WHERE pantry_items.user_id = ? AND "+covers+
+				" ORDER BY pantry_items.expires_on ASC NULLS LAST, pantry_items.id ASC LIMIT 1) AS pantry ON TRUE",
+			userID,
+		).
+		Where("recipe_ingredients.food_recipe_id IN ? AND recipe_ingredients.deleted_at IS NULL", recipeIDs).
+		Order("recipe_ingredients.food_recipe_id ASC, recipe_ingredients.position ASC").
+		Scan(&matches).Error
+	return matches, err
+}
@@ -0,0 +1,124 @@
+package pantry_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pantry"
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const userID = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	container *postgres.PostgresContainer
+	db        *gorm.DB
+	repo      pantry.IRepository
+}
+
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("..", "..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repo = &pantry.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+type RepositoryGetMatchesTestSuite struct {
+	RepositoryTestSuite
+}
+
+// covered reports whether a pantry holding only pantryName covers a recipe
+// ingredient named ingredientName.
+func (suite *RepositoryGetMatchesTestSuite) covered(pantryName string, ingredientName string) bool {
+	recipe := model.FoodRecipe{
+		Name:              ingredientName,
+		Status:            model.RecipeStatusPublished,
+		CookingDurationID: 1,
+		DifficultyID:      1,
+		UserID:            userID,
+		Ingredients: model.RecipeIngredients{
+			{Name: ingredientName, SearchName: search.Name(ingredientName), Position: 1},
+		},
+	}
+	suite.Require().NoError(suite.db.Create(&recipe).Error)
+
+	item := model.PantryItem{UserID: userID, Name: pantryName, SearchName: search.Name(pantryName)}
+	suite.Require().NoError(suite.repo.Create(&item))
+	defer suite.repo.Delete(item.ID)
+
+	matches, err := suite.repo.GetMatches(userID, []uint{recipe.ID})
+	suite.Require().NoError(err)
+	suite.Require().Len(matches, 1)
+
+	return matches[0].PantryItemID != nil
+}
+
+func (suite *RepositoryGetMatchesTestSuite) TestCoverWholeWords() {
+	suite.True(suite.covered("eggs", "egg"))
+	suite.True(suite.covered("chicken", "chicken thigh"))
+	suite.True(suite.covered("หมู", "หมูสับ"))
+}
+
+func (suite *RepositoryGetMatchesTestSuite) TestNotCoverPartOfWord() {
+	suite.False(suite.covered("egg", "eggplant"))
+	suite.False(suite.covered("salt", "unsalted butter"))
+	suite.False(suite.covered("น้ำ", "น้ำปลา"))
+	suite.False(suite.covered("น้ำ", "น้ำตาล"))
+	suite.False(suite.covered("น้ำ", "น้ำมัน"))
+	suite.False(suite.covered("ขนมปัง", "นม"))
+}
+
+func TestRepositoryGetMatches(t *testing.T) {
+	suite.Run(t, new(RepositoryGetMatchesTestSuite))
+}
@@ -0,0 +1,244 @@
+package pantry
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ExpiringWithin is how many days ahead an item counts as expiring soon when
+// the query does not say.
+const ExpiringWithin = 3
+
+type IService interface {
+	Get(claims model.Claims) (model.PantryItems, error)
+	Add(request dto.PantryItemRequest, claims model.Claims) (model.PantryItem, error)
+	Update(request dto.PantryItemRequest, id string, claims model.Claims) (model.PantryItem, error)
+	Delete(id string, claims model.Claims) error
+	GetCookable(query model.CookableQuery, claims model.Claims) (model.CookableRecipes, int64, pagination.Cursors, error)
+	ExpiresBy(query model.CookableQuery) time.Time
+	Relabel() (int, error)
+}
+
+type Service struct {
+	Repository IRepository
+	Recipes    foodrecipe.IRepository
+	Now        func() time.Time
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+		Recipes:    foodrecipe.NewRepository(db),
+		Now:        time.Now,
+	}
+}
+
+// ExpiresBy returns the last date an item expiring on counts as expiring
+// soon.
+func (service Service) ExpiresBy(query model.CookableQuery) time.Time {
+	days := query.ExpiringWithin
+	if days <= 0 {
+		days = ExpiringWithin
+	}
+
+	now := service.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return today.AddDate(0, 0, days)
+}
+
+func (service Service) Get(claims model.Claims) (model.PantryItems, error) {
+	items, err := service.Repository.Get(claims.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pantry")
+	}
+
+	return items, nil
+}
+
+// Add records an item on hand. An item of the same name replaces the one
+// already there, so restocking does not make duplicates.
+func (service Service) Add(request dto.PantryItemRequest, claims model.Claims) (model.PantryItem, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.PantryItem{}, errors.Wrap(err, "request invalid")
+	}
+
+	// Looked up by the name as it is saved, so " Eggs " finds "eggs"
+	item, err := service.Repository.GetByName(claims.ID, strings.TrimSpace(request.Name))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return model.PantryItem{}, errors.Wrap(err, "find pantry item")
+	}
+
+	item = item.FromRequest(request, claims)
+
+	if item.ID == 0 {
+		err = service.Repository.Create(&item)
+	} else {
+		err = service.Repository.Update(&item)
+	}
+	if err != nil {
+		return model.PantryItem{}, errors.Wrap(err, "save pantry item")
+	}
+
+	return item, nil
+}
+
+// findOwned returns the item when the caller owns it. Pantries are private,
+// so an item of someone else is reported as missing.
+func (service Service) findOwned(id string, claims model.Claims) (model.PantryItem, error) {
+	item, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.PantryItem{}, errors.Wrap(err, "find pantry item")
+	}
+
+	if item.UserID != claims.ID {
+		return model.PantryItem{}, errors.Wrap(gorm.ErrRecordNotFound, "find pantry item")
+	}
+
+	return item, nil
+}
+
+func (service Service) Update(request dto.PantryItemRequest, id string, claims model.Claims) (model.PantryItem, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.PantryItem{}, errors.Wrap(err, "request invalid")
+	}
+
+	item, err := service.findOwned(id, claims)
+	if err != nil {
+		return model.PantryItem{}, err
+	}
+
+	item = item.FromRequest(request, claims)
+
+	if err := service.Repository.Update(&item); err != nil {
+		return model.PantryItem{}, errors.Wrap(err, "update pantry item")
+	}
+
+	return item, nil
+}
+
+func (service Service) Delete(id string, claims model.Claims) error {
+	item, err := service.findOwned(id, claims)
+	if err != nil {
+		return err
+	}
+
+	return service.Repository.Delete(item.ID)
+}
+
+// GetCookable ranks recipes by the share of their ingredients the pantry
+// covers. The ranking is done by the database; the page is then filled in
+// with the recipes, the ingredients missing and the pantry items each recipe
+// would use that expire soon.
+func (service Service) GetCookable(query model.CookableQuery, claims model.Claims) (model.CookableRecipes, int64, pagination.Cursors, error) {
+	total, err := service.Repository.CountCookable(claims.ID, query)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "count cookable recipes")
+	}
+
+	coverages, cursors, err := service.Repository.GetCookable(claims.ID, query)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "get cookable recipes")
+	}
+
+	ids := make([]uint, 0, len(coverages))
+	for _, coverage := range coverages {
+		ids = append(ids, coverage.ID)
+	}
+
+	recipes, err := service.Recipes.GetByIDs(ids)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "get recipes")
+	}
+
+	matches, err := service.Repository.GetMatches(claims.ID, ids)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "match ingredients")
+	}
+
+	items, err := service.Repository.Get(claims.ID)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "get pantry")
+	}
+
+	return cookable(coverages, recipes, matches, items, service.ExpiresBy(query)), total, cursors, nil
+}
+
+// cookable puts the ranking together in its order. A recipe deleted since it
+// was ranked is skipped.
+func cookable(coverages []model.RecipeCoverage, recipes model.FoodRecipes, matches []model.IngredientMatch, items model.PantryItems, expiresBy time.Time) model.CookableRecipes {
+	recipesByID := make(map[uint]model.FoodRecipe, len(recipes))
+	for _, recipe := range recipes {
+		recipesByID[recipe.ID] = recipe
+	}
+
+	itemsByID := make(map[uint]model.PantryItem, len(items))
+	for _, item := range items {
+		itemsByID[item.ID] = item
+	}
+
+	missing := make(map[uint][]string)
+	expiring := make(map[uint]model.PantryItems)
+	used := make(map[uint]map[uint]bool)
+	for _, match := range matches {
+		if match.PantryItemID == nil {
+			missing[match.FoodRecipeID] = append(missing[match.FoodRecipeID], match.Name)
+			continue
+		}
+
+		item, ok := itemsByID[*match.PantryItemID]
+		if !ok || !item.ExpiresBy(expiresBy) || used[match.FoodRecipeID][item.ID] {
+			continue
+		}
+		if used[match.FoodRecipeID] == nil {
+			used[match.FoodRecipeID] = make(map[uint]bool)
+		}
+		used[match.FoodRecipeID][item.ID] = true
+		expiring[match.FoodRecipeID] = append(expiring[match.FoodRecipeID], item)
+	}
+
+	results := make(model.CookableRecipes, 0, len(coverages))
+	for _, coverage := range coverages {
+		recipe, ok := recipesByID[coverage.ID]
+		if !ok {
+			continue
+		}
+
+		results = append(results, model.CookableRecipe{
+			Recipe:   recipe,
+			Coverage: coverage,
+			Missing:  missing[coverage.ID],
+			Expiring: expiring[coverage.ID],
+		})
+	}
+
+	return results
+}
+
+// Relabel splits the name of every pantry item into words again. Run it after
+// the search dictionary changes.
+func (service Service) Relabel() (int, error) {
+	items, err := service.Repository.GetAll()
+	if err != nil {
+		return 0, errors.Wrap(err, "get all pantry items")
+	}
+
+	for index, item := range items {
+		item.SearchName = search.Name(item.Name)
+		if err := service.Repository.UpdateSearchName(&item); err != nil {
+			return index, errors.Wrapf(err, "relabel pantry item %d", item.ID)
+		}
+	}
+
+	return len(items), nil
+}
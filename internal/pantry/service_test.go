@@ -0,0 +1,235 @@
+package pantry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pantry"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+const (
+	ownerID    = "owner"
+	strangerID = "stranger"
+)
+
+func date(value string) *time.Time {
+	parsed, _ := time.Parse(model.DateLayout, value)
+	return &parsed
+}
+
+func itemID(value uint) *uint {
+	return &value
+}
+
+type ServiceAddTestSuite struct {
+	suite.Suite
+
+	service pantry.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceAddTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &pantry.Service{Repository: suite.repo}
+
+	suite.repo.On("GetByName", ownerID, "Eggs").Return(model.PantryItem{ID: 3, UserID: ownerID, Name: "eggs"}, nil)
+	suite.repo.On("GetByName", ownerID, mock.Anything).Return(model.PantryItem{}, gorm.ErrRecordNotFound)
+	suite.repo.On("Create", mock.Anything).Return(nil)
+	suite.repo.On("Update", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceAddTestSuite) TestCreateNewItem() {
+	item, err := suite.service.Add(dto.PantryItemRequest{Name: " rice ", ExpiresOn: "2026-10-20"}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal("rice", item.Name)
+	suite.Equal("rice", item.SearchName)
+	suite.Equal(date("2026-10-20"), item.ExpiresOn)
+	suite.repo.AssertCalled(suite.T(), "Create", mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceAddTestSuite) TestReplaceItemOfSameName() {
+	item, err := suite.service.Add(dto.PantryItemRequest{Name: "Eggs"}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal(uint(3), item.ID)
+	suite.repo.AssertCalled(suite.T(), "Update", mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceAddTestSuite) TestReplaceItemOfSameNameWithSpaces() {
+	item, err := suite.service.Add(dto.PantryItemRequest{Name: " Eggs "}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal(uint(3), item.ID)
+	suite.Equal("Eggs", item.Name)
+	suite.repo.AssertCalled(suite.T(), "GetByName", ownerID, "Eggs")
+	suite.repo.AssertNotCalled(suite.T(), "GetByName", ownerID, " Eggs ")
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceAddTestSuite) TestInvalidExpiry() {
+	_, err := suite.service.Add(dto.PantryItemRequest{Name: "rice", ExpiresOn: "20-10-2026"}, model.Claims{ID: ownerID})
+	suite.Error(err)
+
+	suite.repo.AssertNotCalled(suite.T(), "GetByName", mock.Anything, mock.Anything)
+}
+
+func TestServiceAdd(t *testing.T) {
+	suite.Run(t, new(ServiceAddTestSuite))
+}
+
+type ServiceDeleteTestSuite struct {
+	suite.Suite
+
+	service pantry.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceDeleteTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &pantry.Service{Repository: suite.repo}
+
+	suite.repo.On("GetByID", "1").Return(model.PantryItem{ID: 1, UserID: ownerID}, nil)
+	suite.repo.On("Delete", uint(1)).Return(nil)
+}
+
+func (suite *ServiceDeleteTestSuite) TestDeleteOwnItem() {
+	suite.NoError(suite.service.Delete("1", model.Claims{ID: ownerID}))
+
+	suite.repo.AssertCalled(suite.T(), "Delete", uint(1))
+}
+
+func (suite *ServiceDeleteTestSuite) TestNotFoundWhenItemOfSomeoneElse() {
+	err := suite.service.Delete("1", model.Claims{ID: strangerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func TestServiceDelete(t *testing.T) {
+	suite.Run(t, new(ServiceDeleteTestSuite))
+}
+
+type ServiceGetCookableTestSuite struct {
+	suite.Suite
+
+	service pantry.IService
+	repo    *MockIRepository
+	recipes *MockRecipeRepository
+}
+
+func (suite *ServiceGetCookableTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.recipes = new(MockRecipeRepository)
+	suite.service = &pantry.Service{
+		Repository: suite.repo,
+		Recipes:    suite.recipes,
+		Now:        func() time.Time { return *date("2026-10-18") },
+	}
+
+	suite.repo.On("CountCookable", ownerID, mock.Anything).Return(int64(3), nil)
+	suite.repo.On("GetCookable", ownerID, mock.Anything).Return([]model.RecipeCoverage{
+		{ID: 11, Ingredients: 2, Covered: 2, Coverage: 1},
+		{ID: 12, Ingredients: 2, Covered: 2, Coverage: 1},
+		{ID: 10, Ingredients: 3, Covered: 2, Coverage: 2.0 / 3},
+	}, pagination.Cursors{}, nil)
+
+	// Recipe 12 was deleted after it was ranked
+	suite.recipes.On("GetByIDs", []uint{11, 12, 10}).Return(model.FoodRecipes{
+		{Model: gorm.Model{ID: 10}, Status: model.RecipeStatusPublished},
+		{Model: gorm.Model{ID: 11}, Status: model.RecipeStatusPublished},
+	}, nil)
+	suite.repo.On("GetMatches", ownerID, []uint{11, 12, 10}).Return([]model.IngredientMatch{
+		{FoodRecipeID: 10, Name: "rice", PantryItemID: itemID(1)},
+		{FoodRecipeID: 10, Name: "egg", PantryItemID: itemID(2)},
+		{FoodRecipeID: 10, Name: "fish sauce"},
+		{FoodRecipeID: 11, Name: "egg yolk", PantryItemID: itemID(2)},
+		{FoodRecipeID: 11, Name: "egg white", PantryItemID: itemID(2)},
+	}, nil)
+	suite.repo.On("Get", ownerID).Return(model.PantryItems{
+		{ID: 2, UserID: ownerID, Name: "eggs", ExpiresOn: date("2026-10-20")},
+		{ID: 1, UserID: ownerID, Name: "rice", ExpiresOn: date("2026-12-01")},
+	}, nil)
+}
+
+func (suite *ServiceGetCookableTestSuite) TestKeepRankingAndSkipDeletedRecipes() {
+	recipes, total, _, err := suite.service.GetCookable(model.CookableQuery{}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Equal(int64(3), total)
+	suite.Len(recipes, 2)
+	suite.Equal(uint(11), recipes[0].Recipe.ID)
+	suite.Equal(uint(10), recipes[1].Recipe.ID)
+}
+
+func (suite *ServiceGetCookableTestSuite) TestListMissingIngredients() {
+	recipes, _, _, err := suite.service.GetCookable(model.CookableQuery{}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Empty(recipes[0].Missing)
+	suite.Equal([]string{"fish sauce"}, recipes[1].Missing)
+}
+
+func (suite *ServiceGetCookableTestSuite) TestHighlightExpiringItemsOnce() {
+	recipes, _, _, err := suite.service.GetCookable(model.CookableQuery{}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Len(recipes[0].Expiring, 1)
+	suite.Equal(uint(2), recipes[0].Expiring[0].ID)
+	suite.Len(recipes[1].Expiring, 1)
+	suite.Equal(uint(2), recipes[1].Expiring[0].ID)
+}
+
+func (suite *ServiceGetCookableTestSuite) TestExpiringWithinFromQuery() {
+	recipes, _, _, err := suite.service.GetCookable(model.CookableQuery{ExpiringWithin: 60}, model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	suite.Len(recipes[1].Expiring, 2)
+}
+
+func TestServiceGetCookable(t *testing.T) {
+	suite.Run(t, new(ServiceGetCookableTestSuite))
+}
+
+type ServiceRelabelTestSuite struct {
+	suite.Suite
+
+	service pantry.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceRelabelTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &pantry.Service{Repository: suite.repo}
+
+	suite.repo.On("GetAll").Return(model.PantryItems{
+		{ID: 1, UserID: ownerID, Name: "Eggs"},
+		{ID: 2, UserID: ownerID, Name: "หมูสับ"},
+	}, nil)
+	suite.repo.On("UpdateSearchName", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceRelabelTestSuite) TestSplitNamesIntoWords() {
+	count, err := suite.service.Relabel()
+	suite.NoError(err)
+
+	suite.Equal(2, count)
+	suite.repo.AssertCalled(suite.T(), "UpdateSearchName", mock.MatchedBy(func(item *model.PantryItem) bool {
+		return item.ID == 1 && item.SearchName == "egg"
+	}))
+	suite.repo.AssertCalled(suite.T(), "UpdateSearchName", mock.MatchedBy(func(item *model.PantryItem) bool {
+		return item.ID == 2 && item.SearchName == "หมู สับ"
+	}))
+}
+
+func TestServiceRelabel(t *testing.T) {
+	suite.Run(t, new(ServiceRelabelTestSuite))
+}
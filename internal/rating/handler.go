@@ -7,7 +7,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
 	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
 	"gorm.io/gorm"
 )
@@ -15,6 +17,7 @@ import (
 type IHandler interface {
 	Create(ctx *gin.Context)
 	GetByID(ctx *gin.Context)
+	GetMine(ctx *gin.Context)
 	Favorite(ctx *gin.Context)
 	IsFavorite(ctx *gin.Context)
 }
@@ -51,20 +54,57 @@ func (handler Handler) Create(ctx *gin.Context) {
 		return
 	}
 
-	rating, err := handler.Service.Create(request, id, claims)
+	rating, created, err := handler.Service.Create(request, id, claims)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if errors.As(err, &validator.ValidationErrors{}) {
+		switch {
+		case errors.As(err, &validator.ValidationErrors{}):
 			statusCode = http.StatusBadRequest
+		case errors.Is(err, global.ErrorOwnRecipe):
+			statusCode = http.StatusForbidden
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			statusCode = http.StatusNotFound
 		}
 
 		ctx.JSON(statusCode, gin.H{"message": err.Error()})
 		return
 	}
 
+	// Rating again replaces the earlier rating
+	if !created {
+		ctx.JSON(http.StatusOK, rating.ToResponse())
+		return
+	}
+
 	ctx.JSON(http.StatusCreated, rating.ToResponse())
 }
 
+func (handler Handler) GetMine(ctx *gin.Context) {
+	recipeID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || recipeID <= 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ID"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Unauthorized"})
+		return
+	}
+
+	rating, err := handler.Service.GetMine(recipeID, claims)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Rating not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rating.ToResponse())
+}
+
 func (handler Handler) GetByID(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
@@ -78,17 +118,32 @@ func (handler Handler) GetByID(ctx *gin.Context) {
 		return
 	}
 
-	ratings, err := handler.Service.GetByID(ratingID)
+	var pageQuery model.PageQuery
+	if err := ctx.ShouldBindQuery(&pageQuery); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, _ := helper.DecodeClaims(ctx)
+
+	ratings, cursors, err := handler.Service.GetByID(ratingID, pageQuery, claims)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, gin.H{"message": "Rating not found"})
 			return
 		}
+		if errors.Is(err, global.ErrorInvalidCursor) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, ratings.ToResponse())
+	response := ratings.ToResponse()
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+
+	ctx.JSON(http.StatusOK, response)
 }
 
 func (handler Handler) Favorite(ctx *gin.Context) {
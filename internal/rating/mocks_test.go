@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -78,6 +79,46 @@ func (_c *MockIHandler_Create_Call) RunAndReturn(run func(ctx *gin.Context)) *Mo
 	return _c
 }
 
+// Favorite provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Favorite(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Favorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Favorite'
+type MockIHandler_Favorite_Call struct {
+	*mock.Call
+}
+
+// Favorite is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Favorite(ctx interface{}) *MockIHandler_Favorite_Call {
+	return &MockIHandler_Favorite_Call{Call: _e.mock.On("Favorite", ctx)}
+}
+
+func (_c *MockIHandler_Favorite_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Favorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Favorite_Call) Return() *MockIHandler_Favorite_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Favorite_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Favorite_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type MockIHandler
 func (_mock *MockIHandler) GetByID(ctx *gin.Context) {
 	_mock.Called(ctx)
@@ -118,6 +159,86 @@ func (_c *MockIHandler_GetByID_Call) RunAndReturn(run func(ctx *gin.Context)) *M
 	return _c
 }
 
+// GetMine provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetMine(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetMine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMine'
+type MockIHandler_GetMine_Call struct {
+	*mock.Call
+}
+
+// GetMine is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetMine(ctx interface{}) *MockIHandler_GetMine_Call {
+	return &MockIHandler_GetMine_Call{Call: _e.mock.On("GetMine", ctx)}
+}
+
+func (_c *MockIHandler_GetMine_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetMine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetMine_Call) Return() *MockIHandler_GetMine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetMine_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetMine_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IsFavorite provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) IsFavorite(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_IsFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFavorite'
+type MockIHandler_IsFavorite_Call struct {
+	*mock.Call
+}
+
+// IsFavorite is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) IsFavorite(ctx interface{}) *MockIHandler_IsFavorite_Call {
+	return &MockIHandler_IsFavorite_Call{Call: _e.mock.On("IsFavorite", ctx)}
+}
+
+func (_c *MockIHandler_IsFavorite_Call) Run(run func(ctx *gin.Context)) *MockIHandler_IsFavorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_IsFavorite_Call) Return() *MockIHandler_IsFavorite_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_IsFavorite_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_IsFavorite_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIRepository(t interface {
@@ -145,16 +266,82 @@ func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
 	return &MockIRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Create(rating *model.Rating) error {
+// AddFavorite provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) AddFavorite(recipeID int, userID string) (bool, error) {
+	ret := _mock.Called(recipeID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFavorite")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (bool, error)); ok {
+		return returnFunc(recipeID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) bool); ok {
+		r0 = returnFunc(recipeID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(recipeID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_AddFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFavorite'
+type MockIRepository_AddFavorite_Call struct {
+	*mock.Call
+}
+
+// AddFavorite is a helper method to define mock.On call
+//   - recipeID int
+//   - userID string
+func (_e *MockIRepository_Expecter) AddFavorite(recipeID interface{}, userID interface{}) *MockIRepository_AddFavorite_Call {
+	return &MockIRepository_AddFavorite_Call{Call: _e.mock.On("AddFavorite", recipeID, userID)}
+}
+
+func (_c *MockIRepository_AddFavorite_Call) Run(run func(recipeID int, userID string)) *MockIRepository_AddFavorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_AddFavorite_Call) Return(b bool, err error) *MockIRepository_AddFavorite_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIRepository_AddFavorite_Call) RunAndReturn(run func(recipeID int, userID string) (bool, error)) *MockIRepository_AddFavorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Delete(rating model.Rating) error {
 	ret := _mock.Called(rating)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Rating) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(model.Rating) error); ok {
 		r0 = returnFunc(rating)
 	} else {
 		r0 = ret.Error(0)
@@ -162,22 +349,22 @@ func (_mock *MockIRepository) Create(rating *model.Rating) error {
 	return r0
 }
 
-// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIRepository_Create_Call struct {
+// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - rating *model.Rating
-func (_e *MockIRepository_Expecter) Create(rating interface{}) *MockIRepository_Create_Call {
-	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", rating)}
+// Delete is a helper method to define mock.On call
+//   - rating model.Rating
+func (_e *MockIRepository_Expecter) Delete(rating interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", rating)}
 }
 
-func (_c *MockIRepository_Create_Call) Run(run func(rating *model.Rating)) *MockIRepository_Create_Call {
+func (_c *MockIRepository_Delete_Call) Run(run func(rating model.Rating)) *MockIRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Rating
+		var arg0 model.Rating
 		if args[0] != nil {
-			arg0 = args[0].(*model.Rating)
+			arg0 = args[0].(model.Rating)
 		}
 		run(
 			arg0,
@@ -186,42 +373,48 @@ func (_c *MockIRepository_Create_Call) Run(run func(rating *model.Rating)) *Mock
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
+func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) RunAndReturn(run func(rating *model.Rating) error) *MockIRepository_Create_Call {
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(rating model.Rating) error) *MockIRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByID provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) GetByID(id int) (model.Ratings, error) {
-	ret := _mock.Called(id)
+func (_mock *MockIRepository) GetByID(id int, query model.PageQuery) (model.Ratings, pagination.Cursors, error) {
+	ret := _mock.Called(id, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
 	}
 
 	var r0 model.Ratings
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.Ratings, error)); ok {
-		return returnFunc(id)
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.PageQuery) (model.Ratings, pagination.Cursors, error)); ok {
+		return returnFunc(id, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.Ratings); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int, model.PageQuery) model.Ratings); ok {
+		r0 = returnFunc(id, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.Ratings)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(int, model.PageQuery) pagination.Cursors); ok {
+		r1 = returnFunc(id, query)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(pagination.Cursors)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(int, model.PageQuery) error); ok {
+		r2 = returnFunc(id, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
@@ -231,107 +424,152 @@ type MockIRepository_GetByID_Call struct {
 
 // GetByID is a helper method to define mock.On call
 //   - id int
-func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
-	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+//   - query model.PageQuery
+func (_e *MockIRepository_Expecter) GetByID(id interface{}, query interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id, query)}
 }
 
-func (_c *MockIRepository_GetByID_Call) Run(run func(id int)) *MockIRepository_GetByID_Call {
+func (_c *MockIRepository_GetByID_Call) Run(run func(id int, query model.PageQuery)) *MockIRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 model.PageQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PageQuery)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_GetByID_Call) Return(ratings model.Ratings, err error) *MockIRepository_GetByID_Call {
-	_c.Call.Return(ratings, err)
+func (_c *MockIRepository_GetByID_Call) Return(ratings model.Ratings, cursors pagination.Cursors, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(ratings, cursors, err)
 	return _c
 }
 
-func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int) (model.Ratings, error)) *MockIRepository_GetByID_Call {
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int, query model.PageQuery) (model.Ratings, pagination.Cursors, error)) *MockIRepository_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIService {
-	mock := &MockIService{}
-	mock.Mock.Test(t)
+// GetMine provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetMine(recipeID int, userID string) (model.Rating, error) {
+	ret := _mock.Called(recipeID, userID)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for GetMine")
+	}
 
-	return mock
+	var r0 model.Rating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (model.Rating, error)); ok {
+		return returnFunc(recipeID, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) model.Rating); ok {
+		r0 = returnFunc(recipeID, userID)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(recipeID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIService is an autogenerated mock type for the IService type
-type MockIService struct {
-	mock.Mock
+// MockIRepository_GetMine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMine'
+type MockIRepository_GetMine_Call struct {
+	*mock.Call
 }
 
-type MockIService_Expecter struct {
-	mock *mock.Mock
+// GetMine is a helper method to define mock.On call
+//   - recipeID int
+//   - userID string
+func (_e *MockIRepository_Expecter) GetMine(recipeID interface{}, userID interface{}) *MockIRepository_GetMine_Call {
+	return &MockIRepository_GetMine_Call{Call: _e.mock.On("GetMine", recipeID, userID)}
 }
 
-func (_m *MockIService) EXPECT() *MockIService_Expecter {
-	return &MockIService_Expecter{mock: &_m.Mock}
+func (_c *MockIRepository_GetMine_Call) Run(run func(recipeID int, userID string)) *MockIRepository_GetMine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
 }
 
-// Create provides a mock function for the type MockIService
-func (_mock *MockIService) Create(request dto.RatingRequest, recipeID int) (model.Rating, error) {
-	ret := _mock.Called(request, recipeID)
+func (_c *MockIRepository_GetMine_Call) Return(rating model.Rating, err error) *MockIRepository_GetMine_Call {
+	_c.Call.Return(rating, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetMine_Call) RunAndReturn(run func(recipeID int, userID string) (model.Rating, error)) *MockIRepository_GetMine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFavorite provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) IsFavorite(recipeID int, userID string) (bool, error) {
+	ret := _mock.Called(recipeID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for IsFavorite")
 	}
 
-	var r0 model.Rating
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int) (model.Rating, error)); ok {
-		return returnFunc(request, recipeID)
+	if returnFunc, ok := ret.Get(0).(func(int, string) (bool, error)); ok {
+		return returnFunc(recipeID, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int) model.Rating); ok {
-		r0 = returnFunc(request, recipeID)
+	if returnFunc, ok := ret.Get(0).(func(int, string) bool); ok {
+		r0 = returnFunc(recipeID, userID)
 	} else {
-		r0 = ret.Get(0).(model.Rating)
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(dto.RatingRequest, int) error); ok {
-		r1 = returnFunc(request, recipeID)
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(recipeID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIService_Create_Call struct {
+// MockIRepository_IsFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFavorite'
+type MockIRepository_IsFavorite_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - request dto.RatingRequest
+// IsFavorite is a helper method to define mock.On call
 //   - recipeID int
-func (_e *MockIService_Expecter) Create(request interface{}, recipeID interface{}) *MockIService_Create_Call {
-	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, recipeID)}
+//   - userID string
+func (_e *MockIRepository_Expecter) IsFavorite(recipeID interface{}, userID interface{}) *MockIRepository_IsFavorite_Call {
+	return &MockIRepository_IsFavorite_Call{Call: _e.mock.On("IsFavorite", recipeID, userID)}
 }
 
-func (_c *MockIService_Create_Call) Run(run func(request dto.RatingRequest, recipeID int)) *MockIService_Create_Call {
+func (_c *MockIRepository_IsFavorite_Call) Run(run func(recipeID int, userID string)) *MockIRepository_IsFavorite_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 dto.RatingRequest
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(dto.RatingRequest)
+			arg0 = args[0].(int)
 		}
-		var arg1 int
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -341,61 +579,2230 @@ func (_c *MockIService_Create_Call) Run(run func(request dto.RatingRequest, reci
 	return _c
 }
 
-func (_c *MockIService_Create_Call) Return(rating model.Rating, err error) *MockIService_Create_Call {
-	_c.Call.Return(rating, err)
+func (_c *MockIRepository_IsFavorite_Call) Return(b bool, err error) *MockIRepository_IsFavorite_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.RatingRequest, recipeID int) (model.Rating, error)) *MockIService_Create_Call {
+func (_c *MockIRepository_IsFavorite_Call) RunAndReturn(run func(recipeID int, userID string) (bool, error)) *MockIRepository_IsFavorite_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockIService
-func (_mock *MockIService) GetByID(id int) (model.Ratings, error) {
-	ret := _mock.Called(id)
+// RemoveFavorite provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) RemoveFavorite(recipeID int, userID string) (bool, error) {
+	ret := _mock.Called(recipeID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for RemoveFavorite")
 	}
 
-	var r0 model.Ratings
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.Ratings, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int, string) (bool, error)); ok {
+		return returnFunc(recipeID, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.Ratings); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int, string) bool); ok {
+		r0 = returnFunc(recipeID, userID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.Ratings)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(recipeID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIService_GetByID_Call struct {
+// MockIRepository_RemoveFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFavorite'
+type MockIRepository_RemoveFavorite_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
-//   - id int
-func (_e *MockIService_Expecter) GetByID(id interface{}) *MockIService_GetByID_Call {
-	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+// RemoveFavorite is a helper method to define mock.On call
+//   - recipeID int
+//   - userID string
+func (_e *MockIRepository_Expecter) RemoveFavorite(recipeID interface{}, userID interface{}) *MockIRepository_RemoveFavorite_Call {
+	return &MockIRepository_RemoveFavorite_Call{Call: _e.mock.On("RemoveFavorite", recipeID, userID)}
 }
 
-func (_c *MockIService_GetByID_Call) Run(run func(id int)) *MockIService_GetByID_Call {
+func (_c *MockIRepository_RemoveFavorite_Call) Run(run func(recipeID int, userID string)) *MockIRepository_RemoveFavorite_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_RemoveFavorite_Call) Return(b bool, err error) *MockIRepository_RemoveFavorite_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIRepository_RemoveFavorite_Call) RunAndReturn(run func(recipeID int, userID string) (bool, error)) *MockIRepository_RemoveFavorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Upsert(rating *model.Rating) (bool, error) {
+	ret := _mock.Called(rating)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Rating) (bool, error)); ok {
+		return returnFunc(rating)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Rating) bool); ok {
+		r0 = returnFunc(rating)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Rating) error); ok {
+		r1 = returnFunc(rating)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockIRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - rating *model.Rating
+func (_e *MockIRepository_Expecter) Upsert(rating interface{}) *MockIRepository_Upsert_Call {
+	return &MockIRepository_Upsert_Call{Call: _e.mock.On("Upsert", rating)}
+}
+
+func (_c *MockIRepository_Upsert_Call) Run(run func(rating *model.Rating)) *MockIRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Rating
+		if args[0] != nil {
+			arg0 = args[0].(*model.Rating)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Upsert_Call) Return(b bool, err error) *MockIRepository_Upsert_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIRepository_Upsert_Call) RunAndReturn(run func(rating *model.Rating) (bool, error)) *MockIRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIService {
+	mock := &MockIService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
+	mock.Mock
+}
+
+type MockIService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIService
+func (_mock *MockIService) Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, bool, error) {
+	ret := _mock.Called(request, recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Rating
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int, model.Claims) (model.Rating, bool, error)); ok {
+		return returnFunc(request, recipeID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int, model.Claims) model.Rating); ok {
+		r0 = returnFunc(request, recipeID, claims)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.RatingRequest, int, model.Claims) bool); ok {
+		r1 = returnFunc(request, recipeID, claims)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(dto.RatingRequest, int, model.Claims) error); ok {
+		r2 = returnFunc(request, recipeID, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.RatingRequest
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Create(request interface{}, recipeID interface{}, claims interface{}) *MockIService_Create_Call {
+	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, recipeID, claims)}
+}
+
+func (_c *MockIService_Create_Call) Run(run func(request dto.RatingRequest, recipeID int, claims model.Claims)) *MockIService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.RatingRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.RatingRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Create_Call) Return(rating model.Rating, b bool, err error) *MockIService_Create_Call {
+	_c.Call.Return(rating, b, err)
+	return _c
+}
+
+func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, bool, error)) *MockIService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Favorite provides a mock function for the type MockIService
+func (_mock *MockIService) Favorite(request dto.FavoriteRequest, recipeID int, claims model.Claims) (bool, error) {
+	ret := _mock.Called(request, recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Favorite")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FavoriteRequest, int, model.Claims) (bool, error)); ok {
+		return returnFunc(request, recipeID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FavoriteRequest, int, model.Claims) bool); ok {
+		r0 = returnFunc(request, recipeID, claims)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FavoriteRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, recipeID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Favorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Favorite'
+type MockIService_Favorite_Call struct {
+	*mock.Call
+}
+
+// Favorite is a helper method to define mock.On call
+//   - request dto.FavoriteRequest
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Favorite(request interface{}, recipeID interface{}, claims interface{}) *MockIService_Favorite_Call {
+	return &MockIService_Favorite_Call{Call: _e.mock.On("Favorite", request, recipeID, claims)}
+}
+
+func (_c *MockIService_Favorite_Call) Run(run func(request dto.FavoriteRequest, recipeID int, claims model.Claims)) *MockIService_Favorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FavoriteRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FavoriteRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Favorite_Call) Return(b bool, err error) *MockIService_Favorite_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIService_Favorite_Call) RunAndReturn(run func(request dto.FavoriteRequest, recipeID int, claims model.Claims) (bool, error)) *MockIService_Favorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIService
+func (_mock *MockIService) GetByID(id int, query model.PageQuery, claims model.Claims) (model.Ratings, pagination.Cursors, error) {
+	ret := _mock.Called(id, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Ratings
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.PageQuery, model.Claims) (model.Ratings, pagination.Cursors, error)); ok {
+		return returnFunc(id, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.PageQuery, model.Claims) model.Ratings); ok {
+		r0 = returnFunc(id, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Ratings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.PageQuery, model.Claims) pagination.Cursors); ok {
+		r1 = returnFunc(id, query, claims)
+	} else {
+		r1 = ret.Get(1).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(2).(func(int, model.PageQuery, model.Claims) error); ok {
+		r2 = returnFunc(id, query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+//   - query model.PageQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetByID(id interface{}, query interface{}, claims interface{}) *MockIService_GetByID_Call {
+	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id, query, claims)}
+}
+
+func (_c *MockIService_GetByID_Call) Run(run func(id int, query model.PageQuery, claims model.Claims)) *MockIService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.PageQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PageQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) Return(ratings model.Ratings, cursors pagination.Cursors, err error) *MockIService_GetByID_Call {
+	_c.Call.Return(ratings, cursors, err)
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id int, query model.PageQuery, claims model.Claims) (model.Ratings, pagination.Cursors, error)) *MockIService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMine provides a mock function for the type MockIService
+func (_mock *MockIService) GetMine(recipeID int, claims model.Claims) (model.Rating, error) {
+	ret := _mock.Called(recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMine")
+	}
+
+	var r0 model.Rating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) (model.Rating, error)); ok {
+		return returnFunc(recipeID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) model.Rating); ok {
+		r0 = returnFunc(recipeID, claims)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.Claims) error); ok {
+		r1 = returnFunc(recipeID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetMine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMine'
+type MockIService_GetMine_Call struct {
+	*mock.Call
+}
+
+// GetMine is a helper method to define mock.On call
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetMine(recipeID interface{}, claims interface{}) *MockIService_GetMine_Call {
+	return &MockIService_GetMine_Call{Call: _e.mock.On("GetMine", recipeID, claims)}
+}
+
+func (_c *MockIService_GetMine_Call) Run(run func(recipeID int, claims model.Claims)) *MockIService_GetMine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetMine_Call) Return(rating model.Rating, err error) *MockIService_GetMine_Call {
+	_c.Call.Return(rating, err)
+	return _c
+}
+
+func (_c *MockIService_GetMine_Call) RunAndReturn(run func(recipeID int, claims model.Claims) (model.Rating, error)) *MockIService_GetMine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMyFavorites provides a mock function for the type MockIService
+func (_mock *MockIService) GetMyFavorites(claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMyFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetMyFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMyFavorites'
+type MockIService_GetMyFavorites_Call struct {
+	*mock.Call
+}
+
+// GetMyFavorites is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetMyFavorites(claims interface{}) *MockIService_GetMyFavorites_Call {
+	return &MockIService_GetMyFavorites_Call{Call: _e.mock.On("GetMyFavorites", claims)}
+}
+
+func (_c *MockIService_GetMyFavorites_Call) Run(run func(claims model.Claims)) *MockIService_GetMyFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetMyFavorites_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIService_GetMyFavorites_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIService_GetMyFavorites_Call) RunAndReturn(run func(claims model.Claims) (model.FoodRecipes, error)) *MockIService_GetMyFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFavorite provides a mock function for the type MockIService
+func (_mock *MockIService) IsFavorite(recipeID int, claims model.Claims) (bool, error) {
+	ret := _mock.Called(recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFavorite")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) (bool, error)); ok {
+		return returnFunc(recipeID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) bool); ok {
+		r0 = returnFunc(recipeID, claims)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.Claims) error); ok {
+		r1 = returnFunc(recipeID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_IsFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFavorite'
+type MockIService_IsFavorite_Call struct {
+	*mock.Call
+}
+
+// IsFavorite is a helper method to define mock.On call
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) IsFavorite(recipeID interface{}, claims interface{}) *MockIService_IsFavorite_Call {
+	return &MockIService_IsFavorite_Call{Call: _e.mock.On("IsFavorite", recipeID, claims)}
+}
+
+func (_c *MockIService_IsFavorite_Call) Run(run func(recipeID int, claims model.Claims)) *MockIService_IsFavorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_IsFavorite_Call) Return(b bool, err error) *MockIService_IsFavorite_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIService_IsFavorite_Call) RunAndReturn(run func(recipeID int, claims model.Claims) (bool, error)) *MockIService_IsFavorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecipeRepository creates a new instance of MockRecipeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecipeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecipeRepository {
+	mock := &MockRecipeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRecipeRepository is an autogenerated mock type for the IRepository type
+type MockRecipeRepository struct {
+	mock.Mock
+}
+
+type MockRecipeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecipeRepository) EXPECT() *MockRecipeRepository_Expecter {
+	return &MockRecipeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) Count(query model.FoodRecipeQuery) (int64, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (int64, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) int64); ok {
+		r0 = returnFunc(query)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockRecipeRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+func (_e *MockRecipeRepository_Expecter) Count(query interface{}) *MockRecipeRepository_Count_Call {
+	return &MockRecipeRepository_Count_Call{Call: _e.mock.On("Count", query)}
+}
+
+func (_c *MockRecipeRepository_Count_Call) Run(run func(query model.FoodRecipeQuery)) *MockRecipeRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_Count_Call) Return(n int64, err error) *MockRecipeRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_Count_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (int64, error)) *MockRecipeRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFavorites provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) CountFavorites(query model.FoodRecipeQuery, userID string) (int64, error) {
+	ret := _mock.Called(query, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFavorites")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) (int64, error)); ok {
+		return returnFunc(query, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) int64); ok {
+		r0 = returnFunc(query, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, string) error); ok {
+		r1 = returnFunc(query, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_CountFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFavorites'
+type MockRecipeRepository_CountFavorites_Call struct {
+	*mock.Call
+}
+
+// CountFavorites is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+//   - userID string
+func (_e *MockRecipeRepository_Expecter) CountFavorites(query interface{}, userID interface{}) *MockRecipeRepository_CountFavorites_Call {
+	return &MockRecipeRepository_CountFavorites_Call{Call: _e.mock.On("CountFavorites", query, userID)}
+}
+
+func (_c *MockRecipeRepository_CountFavorites_Call) Run(run func(query model.FoodRecipeQuery, userID string)) *MockRecipeRepository_CountFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_CountFavorites_Call) Return(n int64, err error) *MockRecipeRepository_CountFavorites_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_CountFavorites_Call) RunAndReturn(run func(query model.FoodRecipeQuery, userID string) (int64, error)) *MockRecipeRepository_CountFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountForks provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) CountForks(recipeID uint) (int64, error) {
+	ret := _mock.Called(recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountForks")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return returnFunc(recipeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = returnFunc(recipeID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_CountForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountForks'
+type MockRecipeRepository_CountForks_Call struct {
+	*mock.Call
+}
+
+// CountForks is a helper method to define mock.On call
+//   - recipeID uint
+func (_e *MockRecipeRepository_Expecter) CountForks(recipeID interface{}) *MockRecipeRepository_CountForks_Call {
+	return &MockRecipeRepository_CountForks_Call{Call: _e.mock.On("CountForks", recipeID)}
+}
+
+func (_c *MockRecipeRepository_CountForks_Call) Run(run func(recipeID uint)) *MockRecipeRepository_CountForks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_CountForks_Call) Return(n int64, err error) *MockRecipeRepository_CountForks_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_CountForks_Call) RunAndReturn(run func(recipeID uint) (int64, error)) *MockRecipeRepository_CountForks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountTags provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) CountTags(ids []uint) (int64, error) {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTags")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint) (int64, error)); ok {
+		return returnFunc(ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint) int64); ok {
+		r0 = returnFunc(ids)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = returnFunc(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_CountTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTags'
+type MockRecipeRepository_CountTags_Call struct {
+	*mock.Call
+}
+
+// CountTags is a helper method to define mock.On call
+//   - ids []uint
+func (_e *MockRecipeRepository_Expecter) CountTags(ids interface{}) *MockRecipeRepository_CountTags_Call {
+	return &MockRecipeRepository_CountTags_Call{Call: _e.mock.On("CountTags", ids)}
+}
+
+func (_c *MockRecipeRepository_CountTags_Call) Run(run func(ids []uint)) *MockRecipeRepository_CountTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_CountTags_Call) Return(n int64, err error) *MockRecipeRepository_CountTags_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_CountTags_Call) RunAndReturn(run func(ids []uint) (int64, error)) *MockRecipeRepository_CountTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) Create(recipe *model.FoodRecipe) error {
+	ret := _mock.Called(recipe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
+		r0 = returnFunc(recipe)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRecipeRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRecipeRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+func (_e *MockRecipeRepository_Expecter) Create(recipe interface{}) *MockRecipeRepository_Create_Call {
+	return &MockRecipeRepository_Create_Call{Call: _e.mock.On("Create", recipe)}
+}
+
+func (_c *MockRecipeRepository_Create_Call) Run(run func(recipe *model.FoodRecipe)) *MockRecipeRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_Create_Call) Return(err error) *MockRecipeRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_Create_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockRecipeRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) Delete(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRecipeRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRecipeRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id string
+func (_e *MockRecipeRepository_Expecter) Delete(id interface{}) *MockRecipeRepository_Delete_Call {
+	return &MockRecipeRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockRecipeRepository_Delete_Call) Run(run func(id string)) *MockRecipeRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_Delete_Call) Return(err error) *MockRecipeRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_Delete_Call) RunAndReturn(run func(id string) error) *MockRecipeRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Facets provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) Facets(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Facets")
+	}
+
+	var r0 model.FoodRecipeFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipeFacets, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipeFacets); ok {
+		r0 = returnFunc(query)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipeFacets)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_Facets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Facets'
+type MockRecipeRepository_Facets_Call struct {
+	*mock.Call
+}
+
+// Facets is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+func (_e *MockRecipeRepository_Expecter) Facets(query interface{}) *MockRecipeRepository_Facets_Call {
+	return &MockRecipeRepository_Facets_Call{Call: _e.mock.On("Facets", query)}
+}
+
+func (_c *MockRecipeRepository_Facets_Call) Run(run func(query model.FoodRecipeQuery)) *MockRecipeRepository_Facets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_Facets_Call) Return(foodRecipeFacets model.FoodRecipeFacets, err error) *MockRecipeRepository_Facets_Call {
+	_c.Call.Return(foodRecipeFacets, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_Facets_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error)) *MockRecipeRepository_Facets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) Get(query model.FoodRecipeQuery) (model.FoodRecipes, pagination.Cursors, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipes, pagination.Cursors, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) pagination.Cursors); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Get(1).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery) error); ok {
+		r2 = returnFunc(query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRecipeRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRecipeRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+func (_e *MockRecipeRepository_Expecter) Get(query interface{}) *MockRecipeRepository_Get_Call {
+	return &MockRecipeRepository_Get_Call{Call: _e.mock.On("Get", query)}
+}
+
+func (_c *MockRecipeRepository_Get_Call) Run(run func(query model.FoodRecipeQuery)) *MockRecipeRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_Get_Call) Return(foodRecipes model.FoodRecipes, cursors pagination.Cursors, err error) *MockRecipeRepository_Get_Call {
+	_c.Call.Return(foodRecipes, cursors, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_Get_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (model.FoodRecipes, pagination.Cursors, error)) *MockRecipeRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetAll() ([]model.FoodRecipe, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]model.FoodRecipe, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []model.FoodRecipe); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.FoodRecipe)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockRecipeRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+func (_e *MockRecipeRepository_Expecter) GetAll() *MockRecipeRepository_GetAll_Call {
+	return &MockRecipeRepository_GetAll_Call{Call: _e.mock.On("GetAll")}
+}
+
+func (_c *MockRecipeRepository_GetAll_Call) Run(run func()) *MockRecipeRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetAll_Call) Return(foodRecipes []model.FoodRecipe, err error) *MockRecipeRepository_GetAll_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetAll_Call) RunAndReturn(run func() ([]model.FoodRecipe, error)) *MockRecipeRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttribution provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetAttribution(recipeID uint) (model.RecipeAttributions, error) {
+	ret := _mock.Called(recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttribution")
+	}
+
+	var r0 model.RecipeAttributions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) (model.RecipeAttributions, error)); ok {
+		return returnFunc(recipeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) model.RecipeAttributions); ok {
+		r0 = returnFunc(recipeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.RecipeAttributions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_GetAttribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttribution'
+type MockRecipeRepository_GetAttribution_Call struct {
+	*mock.Call
+}
+
+// GetAttribution is a helper method to define mock.On call
+//   - recipeID uint
+func (_e *MockRecipeRepository_Expecter) GetAttribution(recipeID interface{}) *MockRecipeRepository_GetAttribution_Call {
+	return &MockRecipeRepository_GetAttribution_Call{Call: _e.mock.On("GetAttribution", recipeID)}
+}
+
+func (_c *MockRecipeRepository_GetAttribution_Call) Run(run func(recipeID uint)) *MockRecipeRepository_GetAttribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetAttribution_Call) Return(recipeAttributions model.RecipeAttributions, err error) *MockRecipeRepository_GetAttribution_Call {
+	_c.Call.Return(recipeAttributions, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetAttribution_Call) RunAndReturn(run func(recipeID uint) (model.RecipeAttributions, error)) *MockRecipeRepository_GetAttribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetByID(id string) (model.FoodRecipe, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipe, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipe); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockRecipeRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockRecipeRepository_Expecter) GetByID(id interface{}) *MockRecipeRepository_GetByID_Call {
+	return &MockRecipeRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockRecipeRepository_GetByID_Call) Run(run func(id string)) *MockRecipeRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockRecipeRepository_GetByID_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetByID_Call) RunAndReturn(run func(id string) (model.FoodRecipe, error)) *MockRecipeRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetByIDs(ids []uint) (model.FoodRecipes, error) {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint) (model.FoodRecipes, error)); ok {
+		return returnFunc(ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint) model.FoodRecipes); ok {
+		r0 = returnFunc(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = returnFunc(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockRecipeRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ids []uint
+func (_e *MockRecipeRepository_Expecter) GetByIDs(ids interface{}) *MockRecipeRepository_GetByIDs_Call {
+	return &MockRecipeRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ids)}
+}
+
+func (_c *MockRecipeRepository_GetByIDs_Call) Run(run func(ids []uint)) *MockRecipeRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetByIDs_Call) Return(foodRecipes model.FoodRecipes, err error) *MockRecipeRepository_GetByIDs_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetByIDs_Call) RunAndReturn(run func(ids []uint) (model.FoodRecipes, error)) *MockRecipeRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCookingDurations provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetCookingDurations() ([]model.CookingDuration, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCookingDurations")
+	}
+
+	var r0 []model.CookingDuration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]model.CookingDuration, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []model.CookingDuration); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CookingDuration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_GetCookingDurations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCookingDurations'
+type MockRecipeRepository_GetCookingDurations_Call struct {
+	*mock.Call
+}
+
+// GetCookingDurations is a helper method to define mock.On call
+func (_e *MockRecipeRepository_Expecter) GetCookingDurations() *MockRecipeRepository_GetCookingDurations_Call {
+	return &MockRecipeRepository_GetCookingDurations_Call{Call: _e.mock.On("GetCookingDurations")}
+}
+
+func (_c *MockRecipeRepository_GetCookingDurations_Call) Run(run func()) *MockRecipeRepository_GetCookingDurations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetCookingDurations_Call) Return(cookingDurations []model.CookingDuration, err error) *MockRecipeRepository_GetCookingDurations_Call {
+	_c.Call.Return(cookingDurations, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetCookingDurations_Call) RunAndReturn(run func() ([]model.CookingDuration, error)) *MockRecipeRepository_GetCookingDurations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDifficulties provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetDifficulties() ([]model.Difficulty, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDifficulties")
+	}
+
+	var r0 []model.Difficulty
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]model.Difficulty, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []model.Difficulty); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Difficulty)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_GetDifficulties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDifficulties'
+type MockRecipeRepository_GetDifficulties_Call struct {
+	*mock.Call
+}
+
+// GetDifficulties is a helper method to define mock.On call
+func (_e *MockRecipeRepository_Expecter) GetDifficulties() *MockRecipeRepository_GetDifficulties_Call {
+	return &MockRecipeRepository_GetDifficulties_Call{Call: _e.mock.On("GetDifficulties")}
+}
+
+func (_c *MockRecipeRepository_GetDifficulties_Call) Run(run func()) *MockRecipeRepository_GetDifficulties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetDifficulties_Call) Return(difficultys []model.Difficulty, err error) *MockRecipeRepository_GetDifficulties_Call {
+	_c.Call.Return(difficultys, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetDifficulties_Call) RunAndReturn(run func() ([]model.Difficulty, error)) *MockRecipeRepository_GetDifficulties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavorites provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetFavorites(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, pagination.Cursors, error) {
+	ret := _mock.Called(query, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) (model.FoodRecipes, pagination.Cursors, error)); ok {
+		return returnFunc(query, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) model.FoodRecipes); ok {
+		r0 = returnFunc(query, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, string) pagination.Cursors); ok {
+		r1 = returnFunc(query, userID)
+	} else {
+		r1 = ret.Get(1).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery, string) error); ok {
+		r2 = returnFunc(query, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRecipeRepository_GetFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavorites'
+type MockRecipeRepository_GetFavorites_Call struct {
+	*mock.Call
+}
+
+// GetFavorites is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+//   - userID string
+func (_e *MockRecipeRepository_Expecter) GetFavorites(query interface{}, userID interface{}) *MockRecipeRepository_GetFavorites_Call {
+	return &MockRecipeRepository_GetFavorites_Call{Call: _e.mock.On("GetFavorites", query, userID)}
+}
+
+func (_c *MockRecipeRepository_GetFavorites_Call) Run(run func(query model.FoodRecipeQuery, userID string)) *MockRecipeRepository_GetFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetFavorites_Call) Return(foodRecipes model.FoodRecipes, cursors pagination.Cursors, err error) *MockRecipeRepository_GetFavorites_Call {
+	_c.Call.Return(foodRecipes, cursors, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetFavorites_Call) RunAndReturn(run func(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, pagination.Cursors, error)) *MockRecipeRepository_GetFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForks provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetForks(recipeID uint, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error) {
+	ret := _mock.Called(recipeID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForks")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(uint, model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)); ok {
+		return returnFunc(recipeID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, model.PageQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(recipeID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, model.PageQuery) pagination.Cursors); ok {
+		r1 = returnFunc(recipeID, query)
+	} else {
+		r1 = ret.Get(1).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(2).(func(uint, model.PageQuery) error); ok {
+		r2 = returnFunc(recipeID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRecipeRepository_GetForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForks'
+type MockRecipeRepository_GetForks_Call struct {
+	*mock.Call
+}
+
+// GetForks is a helper method to define mock.On call
+//   - recipeID uint
+//   - query model.PageQuery
+func (_e *MockRecipeRepository_Expecter) GetForks(recipeID interface{}, query interface{}) *MockRecipeRepository_GetForks_Call {
+	return &MockRecipeRepository_GetForks_Call{Call: _e.mock.On("GetForks", recipeID, query)}
+}
+
+func (_c *MockRecipeRepository_GetForks_Call) Run(run func(recipeID uint, query model.PageQuery)) *MockRecipeRepository_GetForks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 model.PageQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PageQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetForks_Call) Return(foodRecipes model.FoodRecipes, cursors pagination.Cursors, err error) *MockRecipeRepository_GetForks_Call {
+	_c.Call.Return(foodRecipes, cursors, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetForks_Call) RunAndReturn(run func(recipeID uint, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)) *MockRecipeRepository_GetForks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevision provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetRevision(recipeID uint, number int) (model.RecipeRevision, error) {
+	ret := _mock.Called(recipeID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 model.RecipeRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, int) (model.RecipeRevision, error)); ok {
+		return returnFunc(recipeID, number)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, int) model.RecipeRevision); ok {
+		r0 = returnFunc(recipeID, number)
+	} else {
+		r0 = ret.Get(0).(model.RecipeRevision)
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = returnFunc(recipeID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type MockRecipeRepository_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - recipeID uint
+//   - number int
+func (_e *MockRecipeRepository_Expecter) GetRevision(recipeID interface{}, number interface{}) *MockRecipeRepository_GetRevision_Call {
+	return &MockRecipeRepository_GetRevision_Call{Call: _e.mock.On("GetRevision", recipeID, number)}
+}
+
+func (_c *MockRecipeRepository_GetRevision_Call) Run(run func(recipeID uint, number int)) *MockRecipeRepository_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetRevision_Call) Return(recipeRevision model.RecipeRevision, err error) *MockRecipeRepository_GetRevision_Call {
+	_c.Call.Return(recipeRevision, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetRevision_Call) RunAndReturn(run func(recipeID uint, number int) (model.RecipeRevision, error)) *MockRecipeRepository_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisions provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) GetRevisions(recipeID uint) (model.RecipeRevisions, error) {
+	ret := _mock.Called(recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 model.RecipeRevisions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint) (model.RecipeRevisions, error)); ok {
+		return returnFunc(recipeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint) model.RecipeRevisions); ok {
+		r0 = returnFunc(recipeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.RecipeRevisions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = returnFunc(recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecipeRepository_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type MockRecipeRepository_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - recipeID uint
+func (_e *MockRecipeRepository_Expecter) GetRevisions(recipeID interface{}) *MockRecipeRepository_GetRevisions_Call {
+	return &MockRecipeRepository_GetRevisions_Call{Call: _e.mock.On("GetRevisions", recipeID)}
+}
+
+func (_c *MockRecipeRepository_GetRevisions_Call) Run(run func(recipeID uint)) *MockRecipeRepository_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetRevisions_Call) Return(recipeRevisions model.RecipeRevisions, err error) *MockRecipeRepository_GetRevisions_Call {
+	_c.Call.Return(recipeRevisions, err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_GetRevisions_Call) RunAndReturn(run func(recipeID uint) (model.RecipeRevisions, error)) *MockRecipeRepository_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) Update(recipe *model.FoodRecipe, editorID string) error {
+	ret := _mock.Called(recipe, editorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe, string) error); ok {
+		r0 = returnFunc(recipe, editorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRecipeRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRecipeRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+//   - editorID string
+func (_e *MockRecipeRepository_Expecter) Update(recipe interface{}, editorID interface{}) *MockRecipeRepository_Update_Call {
+	return &MockRecipeRepository_Update_Call{Call: _e.mock.On("Update", recipe, editorID)}
+}
+
+func (_c *MockRecipeRepository_Update_Call) Run(run func(recipe *model.FoodRecipe, editorID string)) *MockRecipeRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_Update_Call) Return(err error) *MockRecipeRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_Update_Call) RunAndReturn(run func(recipe *model.FoodRecipe, editorID string) error) *MockRecipeRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDerived provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) UpdateDerived(recipe *model.FoodRecipe) error {
+	ret := _mock.Called(recipe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDerived")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
+		r0 = returnFunc(recipe)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRecipeRepository_UpdateDerived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDerived'
+type MockRecipeRepository_UpdateDerived_Call struct {
+	*mock.Call
+}
+
+// UpdateDerived is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+func (_e *MockRecipeRepository_Expecter) UpdateDerived(recipe interface{}) *MockRecipeRepository_UpdateDerived_Call {
+	return &MockRecipeRepository_UpdateDerived_Call{Call: _e.mock.On("UpdateDerived", recipe)}
+}
+
+func (_c *MockRecipeRepository_UpdateDerived_Call) Run(run func(recipe *model.FoodRecipe)) *MockRecipeRepository_UpdateDerived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_UpdateDerived_Call) Return(err error) *MockRecipeRepository_UpdateDerived_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_UpdateDerived_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockRecipeRepository_UpdateDerived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function for the type MockRecipeRepository
+func (_mock *MockRecipeRepository) UpdateStatus(recipe *model.FoodRecipe) error {
+	ret := _mock.Called(recipe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
+		r0 = returnFunc(recipe)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRecipeRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockRecipeRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+func (_e *MockRecipeRepository_Expecter) UpdateStatus(recipe interface{}) *MockRecipeRepository_UpdateStatus_Call {
+	return &MockRecipeRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", recipe)}
+}
+
+func (_c *MockRecipeRepository_UpdateStatus_Call) Run(run func(recipe *model.FoodRecipe)) *MockRecipeRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.FoodRecipe
+		if args[0] != nil {
+			arg0 = args[0].(*model.FoodRecipe)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecipeRepository_UpdateStatus_Call) Return(err error) *MockRecipeRepository_UpdateStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRecipeRepository_UpdateStatus_Call) RunAndReturn(run func(recipe *model.FoodRecipe) error) *MockRecipeRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserService creates a new instance of MockUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserService {
+	mock := &MockUserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserService is an autogenerated mock type for the IService type
+type MockUserService struct {
+	mock.Mock
+}
+
+type MockUserService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
+	return &MockUserService_Expecter{mock: &_m.Mock}
+}
+
+// GetByID provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetByID(id string) (model.User, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.User, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.User); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockUserService_Expecter) GetByID(id interface{}) *MockUserService_GetByID_Call {
+	return &MockUserService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockUserService_GetByID_Call) Run(run func(id string)) *MockUserService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetByID_Call) Return(user model.User, err error) *MockUserService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_GetByID_Call) RunAndReturn(run func(id string) (model.User, error)) *MockUserService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMyFavorites provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetMyFavorites(userID string) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMyFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipes); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_GetMyFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMyFavorites'
+type MockUserService_GetMyFavorites_Call struct {
+	*mock.Call
+}
+
+// GetMyFavorites is a helper method to define mock.On call
+//   - userID string
+func (_e *MockUserService_Expecter) GetMyFavorites(userID interface{}) *MockUserService_GetMyFavorites_Call {
+	return &MockUserService_GetMyFavorites_Call{Call: _e.mock.On("GetMyFavorites", userID)}
+}
+
+func (_c *MockUserService_GetMyFavorites_Call) Run(run func(userID string)) *MockUserService_GetMyFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetMyFavorites_Call) Return(foodRecipes model.FoodRecipes, err error) *MockUserService_GetMyFavorites_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockUserService_GetMyFavorites_Call) RunAndReturn(run func(userID string) (model.FoodRecipes, error)) *MockUserService_GetMyFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetRecipes(userID string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 pagination.Cursors
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.PageQuery, model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.PageQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.PageQuery, model.Claims) int64); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.PageQuery, model.Claims) pagination.Cursors); ok {
+		r2 = returnFunc(userID, query, claims)
+	} else {
+		r2 = ret.Get(2).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(3).(func(string, model.PageQuery, model.Claims) error); ok {
+		r3 = returnFunc(userID, query, claims)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockUserService_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - query model.PageQuery
+//   - claims model.Claims
+func (_e *MockUserService_Expecter) GetRecipes(userID interface{}, query interface{}, claims interface{}) *MockUserService_GetRecipes_Call {
+	return &MockUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, query, claims)}
+}
+
+func (_c *MockUserService_GetRecipes_Call) Run(run func(userID string, query model.PageQuery, claims model.Claims)) *MockUserService_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.PageQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PageQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, n int64, cursors pagination.Cursors, err error) *MockUserService_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, n, cursors, err)
+	return _c
+}
+
+func (_c *MockUserService_GetRecipes_Call) RunAndReturn(run func(userID string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)) *MockUserService_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockUserService
+func (_mock *MockUserService) Update(id string, request dto.UserRequest, claims model.Claims) (model.User, error) {
+	ret := _mock.Called(id, request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, dto.UserRequest, model.Claims) (model.User, error)); ok {
+		return returnFunc(id, request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, dto.UserRequest, model.Claims) model.User); ok {
+		r0 = returnFunc(id, request, claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, dto.UserRequest, model.Claims) error); ok {
+		r1 = returnFunc(id, request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockUserService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - id string
+//   - request dto.UserRequest
+//   - claims model.Claims
+func (_e *MockUserService_Expecter) Update(id interface{}, request interface{}, claims interface{}) *MockUserService_Update_Call {
+	return &MockUserService_Update_Call{Call: _e.mock.On("Update", id, request, claims)}
+}
+
+func (_c *MockUserService_Update_Call) Run(run func(id string, request dto.UserRequest, claims model.Claims)) *MockUserService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 dto.UserRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.UserRequest)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Update_Call) Return(user model.User, err error) *MockUserService_Update_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_Update_Call) RunAndReturn(run func(id string, request dto.UserRequest, claims model.Claims) (model.User, error)) *MockUserService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertWithClaims provides a mock function for the type MockUserService
+func (_mock *MockUserService) UpsertWithClaims(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWithClaims")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_UpsertWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertWithClaims'
+type MockUserService_UpsertWithClaims_Call struct {
+	*mock.Call
+}
+
+// UpsertWithClaims is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockUserService_Expecter) UpsertWithClaims(claims interface{}) *MockUserService_UpsertWithClaims_Call {
+	return &MockUserService_UpsertWithClaims_Call{Call: _e.mock.On("UpsertWithClaims", claims)}
+}
+
+func (_c *MockUserService_UpsertWithClaims_Call) Run(run func(claims model.Claims)) *MockUserService_UpsertWithClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
 		run(
 			arg0,
 		)
@@ -403,12 +2810,12 @@ func (_c *MockIService_GetByID_Call) Run(run func(id int)) *MockIService_GetByID
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) Return(ratings model.Ratings, err error) *MockIService_GetByID_Call {
-	_c.Call.Return(ratings, err)
+func (_c *MockUserService_UpsertWithClaims_Call) Return(user model.User, err error) *MockUserService_UpsertWithClaims_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id int) (model.Ratings, error)) *MockIService_GetByID_Call {
+func (_c *MockUserService_UpsertWithClaims_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockUserService_UpsertWithClaims_Call {
 	_c.Call.Return(run)
 	return _c
 }
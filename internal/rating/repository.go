@@ -2,14 +2,17 @@ package rating
 
 import (
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type IRepository interface {
-	Create(rating *model.Rating) error
-	GetByID(id int) (model.Ratings, error)
+	Upsert(rating *model.Rating) (bool, error)
+	Delete(rating model.Rating) error
+	GetMine(recipeID int, userID string) (model.Rating, error)
+	GetByID(id int, query model.PageQuery) (model.Ratings, pagination.Cursors, error)
 	IsFavorite(recipeID int, userID string) (bool, error)
 	AddFavorite(recipeID int, userID string) (bool, error)
 	RemoveFavorite(recipeID int, userID string) (bool, error)
@@ -25,22 +28,97 @@ func NewRepository(db *gorm.DB) IRepository {
 	}
 }
 
-func (repo Repository) Create(rating *model.Rating) error {
-	if err := repo.DB.Create(rating).First(&rating).Error; err != nil {
+// Upsert keeps one rating per user and recipe: rating again replaces the
+// score, and brings back a rating that was deleted. It tells whether the
+// rating is a new one.
+func (repo Repository) Upsert(rating *model.Rating) (bool, error) {
+	var created bool
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "food_recipe_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"score", "updated_at", "deleted_at"}),
+		}).Create(rating).Error
+		if err != nil {
+			return errors.Wrap(err, "upsert rating")
+		}
+
+		// The conflict keeps the first creation time
+		if err := tx.First(rating, rating.ID).Error; err != nil {
+			return err
+		}
+		created = rating.CreatedAt.Equal(rating.UpdatedAt)
+
+		return summarize(tx, rating.FoodRecipeID)
+	})
+	return created, err
+}
+
+// Delete soft deletes a rating.
+func (repo Repository) Delete(rating model.Rating) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.Rating{}, rating.ID).Error; err != nil {
+			return err
+		}
+
+		return summarize(tx, rating.FoodRecipeID)
+	})
+}
+
+// summarize writes the rating count and average of a recipe from its ratings.
+// The recipe is locked first, so ratings written at the same time are all
+// counted by whichever transaction summarizes last.
+func summarize(tx *gorm.DB, recipeID uint) error {
+	var locked []uint
+	err := tx.Table("food_recipes").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", recipeID).
+		Pluck("id", &locked).Error
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return tx.Exec(`
+		UPDATE food_recipes SET
+			rating_count = summary.count,
+			average_rating = summary.average
+		FROM (
+			SELECT COUNT(*) AS count, COALESCE(AVG(score), 0)::DOUBLE PRECISION AS average
+			FROM ratings
+			WHERE food_recipe_id = ? AND deleted_at IS NULL
+		) AS summary
+		WHERE food_recipes.id = ?`, recipeID, recipeID).Error
+}
+
+func (repo Repository) GetMine(recipeID int, userID string) (model.Rating, error) {
+	var rating model.Rating
+	err := repo.DB.Where("food_recipe_id = ? AND user_id = ?", recipeID, userID).First(&rating).Error
+	return rating, err
 }
 
-func (repo Repository) GetByID(id int) (model.Ratings, error) {
+// GetByID returns the ratings of a recipe, oldest first. Without a limit or
+// cursor every rating is returned, as before paging was added.
+func (repo Repository) GetByID(id int, query model.PageQuery) (model.Ratings, pagination.Cursors, error) {
 	var ratings model.Ratings
 
-	if err := repo.DB.Where("food_recipe_id = ?", id).Find(&ratings).Error; err != nil {
-		return nil, err
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	limit := query.Size(0)
+	order := pagination.Order{Table: "ratings"}
+
+	err = repo.DB.Scopes(pagination.Scope(order, cursor, limit, query.Offset(limit))).
+		Where("food_recipe_id = ?", id).
+		Find(&ratings).Error
+	if err != nil {
+		return nil, pagination.Cursors{}, err
 	}
 
-	return ratings, nil
+	ratings, cursors := pagination.Paginate(ratings, limit, cursor, query.Offset(limit) > 0, func(rating model.Rating) pagination.Cursor {
+		return pagination.Cursor{ID: rating.ID}
+	})
+	return ratings, cursors, nil
 }
 
 func (repo Repository) IsFavorite(recipeID int, userID string) (bool, error) {
@@ -1,17 +1,23 @@
 package rating
 
 import (
+	"strconv"
+
 	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
 	"github.com/klins/devpool/go-day6/wongnok/internal/user"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
 type IService interface {
-	Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error)
-	GetByID(id int) (model.Ratings, error)
+	Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, bool, error)
+	GetMine(recipeID int, claims model.Claims) (model.Rating, error)
+	GetByID(id int, query model.PageQuery, claims model.Claims) (model.Ratings, pagination.Cursors, error)
 	GetMyFavorites(claims model.Claims) (model.FoodRecipes, error)
 	IsFavorite(recipeID int, claims model.Claims) (bool, error)
 	Favorite(request dto.FavoriteRequest, recipeID int, claims model.Claims) (bool, error)
@@ -20,46 +26,94 @@ type IService interface {
 type Service struct {
 	Repository   IRepository
 	IUserService user.IService
+	Recipes      foodrecipe.IRepository
 }
 
 func NewService(db *gorm.DB) IService {
 	return &Service{
 		Repository:   NewRepository(db),
 		IUserService: user.NewService(db),
+		Recipes:      foodrecipe.NewRepository(db),
 	}
 }
 
-func (service Service) Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error) {
+// Create rates a recipe the caller may see and did not write, replacing the
+// caller's earlier rating of it. It tells whether the rating is a new one.
+func (service Service) Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, bool, error) {
 	validate := validator.New()
 	if err := validate.Struct(request); err != nil {
-		return model.Rating{}, errors.Wrap(err, "request invalid")
+		return model.Rating{}, false, errors.Wrap(err, "request invalid")
 	}
 
 	// Verify user
 	user, err := service.IUserService.GetByID(claims.ID)
 	if err != nil {
-		return model.Rating{}, errors.Wrap(err, "get user by ID")
+		return model.Rating{}, false, errors.Wrap(err, "get user by ID")
+	}
+
+	recipe, err := service.findRecipe(recipeID, claims)
+	if err != nil {
+		return model.Rating{}, false, err
+	}
+
+	if recipe.UserID == user.ID {
+		return model.Rating{}, false, global.ErrorOwnRecipe
 	}
 
 	var rating model.Rating
 	rating = rating.FromRequest(request)
-	rating.FoodRecipeID = uint(recipeID)
+	rating.FoodRecipeID = recipe.ID
 	rating.UserID = user.ID
 
-	if err := service.Repository.Create(&rating); err != nil {
-		return model.Rating{}, errors.Wrap(err, "create recipe")
+	created, err := service.Repository.Upsert(&rating)
+	if err != nil {
+		return model.Rating{}, false, errors.Wrap(err, "rate recipe")
+	}
+
+	return rating, created, nil
+}
+
+// findRecipe returns the recipe when the caller may see it. Otherwise it is
+// reported as missing, so ratings do not give away drafts or private recipes.
+func (service Service) findRecipe(recipeID int, claims model.Claims) (model.FoodRecipe, error) {
+	recipe, err := service.Recipes.GetByID(strconv.Itoa(recipeID))
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "find recipe")
+	}
+
+	if !recipe.VisibleTo(claims.ID) {
+		return model.FoodRecipe{}, errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+	}
+
+	return recipe, nil
+}
+
+// GetMine returns the caller's rating of a recipe they may see.
+func (service Service) GetMine(recipeID int, claims model.Claims) (model.Rating, error) {
+	if _, err := service.findRecipe(recipeID, claims); err != nil {
+		return model.Rating{}, err
+	}
+
+	rating, err := service.Repository.GetMine(recipeID, claims.ID)
+	if err != nil {
+		return model.Rating{}, errors.Wrap(err, "get rating")
 	}
 
 	return rating, nil
 }
 
-func (service Service) GetByID(id int) (model.Ratings, error) {
-	ratings, err := service.Repository.GetByID(id)
+// GetByID lists the ratings of a recipe the caller may see.
+func (service Service) GetByID(id int, query model.PageQuery, claims model.Claims) (model.Ratings, pagination.Cursors, error) {
+	if _, err := service.findRecipe(id, claims); err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	ratings, cursors, err := service.Repository.GetByID(id, query)
 	if err != nil {
-		return nil, err
+		return nil, pagination.Cursors{}, err
 	}
 
-	return ratings, nil
+	return ratings, cursors, nil
 }
 
 func (service Service) GetMyFavorites(claims model.Claims) (model.FoodRecipes, error) {
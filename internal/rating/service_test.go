@@ -0,0 +1,121 @@
+package rating_test
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/klins/devpool/go-day6/wongnok/internal/rating"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+const (
+	authorID = "author"
+	raterID  = "rater"
+)
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	service rating.IService
+	repo    *MockIRepository
+	users   *MockUserService
+	recipes *MockRecipeRepository
+}
+
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.users = new(MockUserService)
+	suite.recipes = new(MockRecipeRepository)
+	suite.service = &rating.Service{
+		Repository:   suite.repo,
+		IUserService: suite.users,
+		Recipes:      suite.recipes,
+	}
+
+	suite.users.On("GetByID", mock.Anything).Return(func(id string) (model.User, error) {
+		return model.User{ID: id}, nil
+	})
+	suite.recipes.On("GetByID", "1").Return(model.FoodRecipe{Model: gorm.Model{ID: 1}, Status: model.RecipeStatusPublished, UserID: authorID}, nil)
+	suite.recipes.On("GetByID", "2").Return(model.FoodRecipe{Model: gorm.Model{ID: 2}, Status: model.RecipeStatusDraft, UserID: authorID}, nil)
+	suite.repo.On("Upsert", mock.Anything).Return(true, nil)
+}
+
+func (suite *ServiceCreateTestSuite) TestRateRecipe() {
+	created, isNew, err := suite.service.Create(dto.RatingRequest{Score: 4.26}, 1, model.Claims{ID: raterID})
+	suite.NoError(err)
+
+	suite.True(isNew)
+	suite.Equal(model.Rating{Score: 4.3, FoodRecipeID: 1, UserID: raterID}, created)
+}
+
+func (suite *ServiceCreateTestSuite) TestScoreOutOfRange() {
+	for _, score := range []float64{0, 0.5, 5.5, -3} {
+		_, _, err := suite.service.Create(dto.RatingRequest{Score: score}, 1, model.Claims{ID: raterID})
+		suite.ErrorAs(err, &validator.ValidationErrors{}, score)
+	}
+
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestRateOwnRecipe() {
+	_, _, err := suite.service.Create(dto.RatingRequest{Score: 5}, 1, model.Claims{ID: authorID})
+	suite.ErrorIs(err, global.ErrorOwnRecipe)
+
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestRateDraftOfSomeoneElse() {
+	_, _, err := suite.service.Create(dto.RatingRequest{Score: 5}, 2, model.Claims{ID: raterID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+type ServiceGetTestSuite struct {
+	suite.Suite
+
+	service rating.IService
+	repo    *MockIRepository
+	recipes *MockRecipeRepository
+}
+
+func (suite *ServiceGetTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.recipes = new(MockRecipeRepository)
+	suite.service = &rating.Service{
+		Repository: suite.repo,
+		Recipes:    suite.recipes,
+	}
+
+	suite.recipes.On("GetByID", "2").Return(model.FoodRecipe{Model: gorm.Model{ID: 2}, Status: model.RecipeStatusDraft, UserID: authorID}, nil)
+	suite.repo.On("GetByID", 2, mock.Anything).Return(model.Ratings{}, pagination.Cursors{}, nil)
+	suite.repo.On("GetMine", 2, mock.Anything).Return(model.Rating{}, nil)
+}
+
+func (suite *ServiceGetTestSuite) TestNotFoundWhenDraftOfSomeoneElse() {
+	_, _, err := suite.service.GetByID(2, model.PageQuery{}, model.Claims{ID: raterID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	_, err = suite.service.GetMine(2, model.Claims{ID: raterID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "GetByID", mock.Anything, mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "GetMine", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceGetTestSuite) TestListRatingsOfOwnDraft() {
+	_, _, err := suite.service.GetByID(2, model.PageQuery{}, model.Claims{ID: authorID})
+	suite.NoError(err)
+}
+
+func TestServiceGet(t *testing.T) {
+	suite.Run(t, new(ServiceGetTestSuite))
+}
@@ -0,0 +1,188 @@
+package render
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+)
+
+var (
+	// cooklangName drops what would end an ingredient name or amount early
+	cooklangName = strings.NewReplacer("{", "", "}", "", "%", "", "(", "", ")", "", "\n", " ")
+	// cooklangText escapes what would start an ingredient, cookware or timer
+	// in the text of a step
+	cooklangText = strings.NewReplacer("@", `\@`, "#", `\#`, "~", `\~`)
+)
+
+// cooklangIngredient writes an ingredient as @name{quantity%unit}(note).
+func cooklangIngredient(ingredient model.RecipeIngredient) string {
+	amount := ""
+	if ingredient.Quantity != nil {
+		amount = formatQuantity(*ingredient.Quantity)
+		if ingredient.Unit != "" {
+			amount += "%" + cooklangName.Replace(ingredient.Unit)
+		}
+	}
+
+	text := "@" + strings.TrimSpace(cooklangName.Replace(ingredient.Name)) + "{" + amount + "}"
+	if note := cooklangName.Replace(ingredient.Note); note != "" {
+		text += "(" + note + ")"
+	}
+	return text
+}
+
+func cooklangTimer(seconds int) string {
+	if seconds%60 == 0 {
+		return "~{" + strconv.Itoa(seconds/60) + "%minutes}"
+	}
+	return "~{" + strconv.Itoa(seconds) + "%seconds}"
+}
+
+// mention is where a step names an ingredient.
+type mention struct {
+	start, end int
+	ingredient model.RecipeIngredient
+}
+
+// markIngredients writes a step with the ingredients it names as Cooklang
+// ingredients. Each ingredient is marked where it is first named in the
+// recipe, longer names first so "fish sauce" is not taken for "fish". Names
+// are matched by whole words, as pantries match them, so "egg" is not found
+// in "eggplant" nor "น้ำ" in "น้ำมัน".
+func markIngredients(text string, ingredients model.RecipeIngredients, marked map[int]bool) string {
+	// The words line up with the spans, both being split by search.Tokenize
+	spans := search.Spans(text)
+	words := strings.Fields(search.Name(text))
+
+	order := make([]int, 0, len(ingredients))
+	for index := range ingredients {
+		order = append(order, index)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return utf8.RuneCountInString(ingredients[order[i]].Name) > utf8.RuneCountInString(ingredients[order[j]].Name)
+	})
+
+	var mentions []mention
+	for _, index := range order {
+		key := strings.Fields(search.Name(ingredients[index].Name))
+		if marked[index] || len(key) == 0 {
+			continue
+		}
+
+		for start := 0; start+len(key) <= len(words); start++ {
+			if !slices.Equal(words[start:start+len(key)], key) {
+				continue
+			}
+
+			location := []int{spans[start][0], spans[start+len(key)-1][1]}
+			if overlaps(mentions, location) {
+				continue
+			}
+			mentions = append(mentions, mention{start: location[0], end: location[1], ingredient: ingredients[index]})
+			marked[index] = true
+			break
+		}
+	}
+
+	sort.Slice(mentions, func(i, j int) bool { return mentions[i].start < mentions[j].start })
+
+	var builder strings.Builder
+	last := 0
+	for _, mention := range mentions {
+		builder.WriteString(cooklangText.Replace(text[last:mention.start]))
+		builder.WriteString(cooklangIngredient(mention.ingredient))
+		last = mention.end
+	}
+	builder.WriteString(cooklangText.Replace(text[last:]))
+
+	return builder.String()
+}
+
+func overlaps(mentions []mention, location []int) bool {
+	for _, mention := range mentions {
+		if location[0] < mention.end && mention.start < location[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// RecipeCooklang writes the recipe as a Cooklang recipe. Cooklang has no
+// ingredient list, ingredients are marked in the steps using them; the ones
+// no step names are listed in a step of their own before the others. Legacy
+// ingredient text cannot be marked and is kept as a note.
+func RecipeCooklang(recipe model.FoodRecipe) string {
+	var builder strings.Builder
+
+	metadata := [][2]string{
+		{"title", recipe.Name},
+		{"description", recipe.Description},
+		{"author", authorName(recipe.User)},
+		{"tags", strings.Join(append(tagNames(recipe), diets(recipe)...), ", ")},
+	}
+	if recipe.Servings > 0 {
+		metadata = append(metadata, [2]string{"servings", strconv.Itoa(recipe.Servings)})
+	}
+	if recipe.ImageURL != nil {
+		metadata = append(metadata, [2]string{"image", *recipe.ImageURL})
+	}
+	for _, entry := range metadata {
+		if value := strings.Join(strings.Fields(entry[1]), " "); value != "" {
+			builder.WriteString(">> " + entry[0] + ": " + value + "\n")
+		}
+	}
+
+	var paragraphs []string
+
+	if len(recipe.Ingredients) == 0 {
+		var notes []string
+		for _, line := range lines(recipe.Ingredient) {
+			notes = append(notes, "> "+line)
+		}
+		if len(notes) > 0 {
+			paragraphs = append(paragraphs, strings.Join(notes, "\n"))
+		}
+	}
+
+	marked := make(map[int]bool, len(recipe.Ingredients))
+	var steps []string
+	if len(recipe.Steps) > 0 {
+		for _, step := range recipe.Steps {
+			text := markIngredients(strings.Join(strings.Fields(step.Text), " "), recipe.Ingredients, marked)
+			if step.DurationSeconds != nil {
+				text += " " + cooklangTimer(*step.DurationSeconds)
+			}
+			steps = append(steps, text)
+		}
+	} else {
+		for _, line := range stepLines(recipe) {
+			steps = append(steps, markIngredients(line, recipe.Ingredients, marked))
+		}
+	}
+
+	var unmarked []string
+	for index, ingredient := range recipe.Ingredients {
+		if !marked[index] {
+			unmarked = append(unmarked, cooklangIngredient(ingredient))
+		}
+	}
+	if len(unmarked) > 0 {
+		paragraphs = append(paragraphs, strings.Join(unmarked, ", "))
+	}
+
+	paragraphs = append(paragraphs, steps...)
+
+	if len(paragraphs) > 0 {
+		if builder.Len() > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(strings.Join(paragraphs, "\n\n") + "\n")
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,148 @@
+package render
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+)
+
+// recipeLD is a schema.org Recipe, with the properties search engines read
+// for rich results.
+type recipeLD struct {
+	Context            string        `json:"@context"`
+	Type               string        `json:"@type"`
+	Name               string        `json:"name"`
+	Description        string        `json:"description,omitempty"`
+	Image              string        `json:"image,omitempty"`
+	Author             *personLD     `json:"author,omitempty"`
+	DatePublished      string        `json:"datePublished,omitempty"`
+	DateModified       string        `json:"dateModified,omitempty"`
+	RecipeYield        string        `json:"recipeYield,omitempty"`
+	Keywords           string        `json:"keywords,omitempty"`
+	SuitableForDiet    []string      `json:"suitableForDiet,omitempty"`
+	RecipeIngredient   []string      `json:"recipeIngredient"`
+	RecipeInstructions []howToStepLD `json:"recipeInstructions"`
+	Nutrition          *nutritionLD  `json:"nutrition,omitempty"`
+	AggregateRating    *ratingLD     `json:"aggregateRating,omitempty"`
+}
+
+type personLD struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+type howToStepLD struct {
+	Type     string `json:"@type"`
+	Position int    `json:"position"`
+	Text     string `json:"text"`
+	Image    string `json:"image,omitempty"`
+}
+
+type nutritionLD struct {
+	Type                string `json:"@type"`
+	ServingSize         string `json:"servingSize"`
+	Calories            string `json:"calories"`
+	ProteinContent      string `json:"proteinContent"`
+	FatContent          string `json:"fatContent"`
+	CarbohydrateContent string `json:"carbohydrateContent"`
+	SodiumContent       string `json:"sodiumContent"`
+}
+
+type ratingLD struct {
+	Type        string  `json:"@type"`
+	RatingValue float64 `json:"ratingValue"`
+	RatingCount int     `json:"ratingCount"`
+	BestRating  int     `json:"bestRating"`
+	WorstRating int     `json:"worstRating"`
+}
+
+// restrictedDiets maps the dietary flags onto the schema.org RestrictedDiet
+// values.
+var restrictedDiets = map[string]string{
+	"vegetarian":     "https://schema.org/VegetarianDiet",
+	"vegan":          "https://schema.org/VeganDiet",
+	"halal-friendly": "https://schema.org/HalalDiet",
+	"gluten-free":    "https://schema.org/GlutenFreeDiet",
+}
+
+// RecipeJSONLD writes the recipe as a schema.org Recipe. The JSON keeps the
+// default escaping of <, > and &, so it can be put in a script tag as is.
+func RecipeJSONLD(recipe model.FoodRecipe) ([]byte, error) {
+	document := recipeLD{
+		Context:            "https://schema.org",
+		Type:               "Recipe",
+		Name:               recipe.Name,
+		Description:        recipe.Description,
+		Keywords:           strings.Join(tagNames(recipe), ", "),
+		RecipeIngredient:   make([]string, 0),
+		RecipeInstructions: make([]howToStepLD, 0),
+	}
+
+	if recipe.ImageURL != nil {
+		document.Image = *recipe.ImageURL
+	}
+	if name := authorName(recipe.User); name != "" {
+		document.Author = &personLD{Type: "Person", Name: name}
+	}
+	if !recipe.CreatedAt.IsZero() {
+		document.DatePublished = recipe.CreatedAt.Format(model.DateLayout)
+	}
+	if !recipe.UpdatedAt.IsZero() {
+		document.DateModified = recipe.UpdatedAt.Format(model.DateLayout)
+	}
+	if recipe.Servings > 0 {
+		document.RecipeYield = strconv.Itoa(recipe.Servings)
+	}
+
+	for _, diet := range diets(recipe) {
+		document.SuitableForDiet = append(document.SuitableForDiet, restrictedDiets[diet])
+	}
+
+	document.RecipeIngredient = append(document.RecipeIngredient, ingredientLines(recipe)...)
+
+	if len(recipe.Steps) > 0 {
+		for _, step := range recipe.Steps {
+			howTo := howToStepLD{Type: "HowToStep", Position: step.Position, Text: step.Text}
+			if step.ImageURL != nil {
+				howTo.Image = *step.ImageURL
+			}
+			document.RecipeInstructions = append(document.RecipeInstructions, howTo)
+		}
+	} else {
+		for index, text := range stepLines(recipe) {
+			document.RecipeInstructions = append(document.RecipeInstructions, howToStepLD{Type: "HowToStep", Position: index + 1, Text: text})
+		}
+	}
+
+	if recipe.Nutrition != nil {
+		perServing := recipe.Nutrition.PerServing
+		document.Nutrition = &nutritionLD{
+			Type:                "NutritionInformation",
+			ServingSize:         "1 serving",
+			Calories:            formatQuantity(roundTenth(perServing.Calories)) + " kcal",
+			ProteinContent:      formatQuantity(roundTenth(perServing.Protein)) + " g",
+			FatContent:          formatQuantity(roundTenth(perServing.Fat)) + " g",
+			CarbohydrateContent: formatQuantity(roundTenth(perServing.Carbs)) + " g",
+			SodiumContent:       formatQuantity(roundTenth(perServing.Sodium)) + " mg",
+		}
+	}
+
+	if recipe.RatingCount > 0 {
+		document.AggregateRating = &ratingLD{
+			Type:        "AggregateRating",
+			RatingValue: roundTenth(recipe.AverageRating),
+			RatingCount: int(recipe.RatingCount),
+			BestRating:  5,
+			WorstRating: 1,
+		}
+	}
+
+	return json.MarshalIndent(document, "", "  ")
+}
+
+func roundTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
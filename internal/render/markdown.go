@@ -0,0 +1,90 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+)
+
+// markdownSpecial are the characters that start Markdown syntax inside a line.
+var markdownSpecial = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`,
+)
+
+// markdownLine escapes text written by authors so it shows as typed, and
+// keeps it on one line.
+func markdownLine(text string) string {
+	text = markdownSpecial.Replace(strings.Join(strings.Fields(text), " "))
+
+	// A leading "#", "-", "+" or "1." would start a heading or a list
+	if strings.HasPrefix(text, "#") || strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+") {
+		text = `\` + text
+	}
+	index := strings.IndexFunc(text, func(r rune) bool { return r < '0' || r > '9' })
+	if index > 0 && text[index] == '.' && (index+1 == len(text) || text[index+1] == ' ') {
+		text = text[:index] + `\` + text[index:]
+	}
+	return text
+}
+
+// RecipeMarkdown writes the recipe as a Markdown document: the name as the
+// title, a line of facts, then the ingredients as a list and the steps as a
+// numbered list.
+func RecipeMarkdown(recipe model.FoodRecipe) string {
+	var builder strings.Builder
+
+	builder.WriteString("# " + markdownLine(recipe.Name) + "\n")
+
+	if recipe.ImageURL != nil && *recipe.ImageURL != "" {
+		builder.WriteString("\n![" + markdownLine(recipe.Name) + "](<" + *recipe.ImageURL + ">)\n")
+	}
+
+	if description := lines(recipe.Description); len(description) > 0 {
+		builder.WriteString("\n")
+		for _, line := range description {
+			builder.WriteString(markdownLine(line) + "\n")
+		}
+	}
+
+	var facts []string
+	if name := authorName(recipe.User); name != "" {
+		facts = append(facts, "**By** "+markdownLine(name))
+	}
+	if recipe.Servings > 0 {
+		facts = append(facts, "**Servings** "+strconv.Itoa(recipe.Servings))
+	}
+	if recipe.CookingDuration.Name != "" {
+		facts = append(facts, "**Time** "+markdownLine(recipe.CookingDuration.Name)+" min")
+	}
+	if recipe.Difficulty.Name != "" {
+		facts = append(facts, "**Difficulty** "+markdownLine(recipe.Difficulty.Name))
+	}
+	if len(facts) > 0 {
+		builder.WriteString("\n" + strings.Join(facts, " · ") + "\n")
+	}
+
+	var labels []string
+	for _, name := range append(tagNames(recipe), diets(recipe)...) {
+		labels = append(labels, markdownLine(name))
+	}
+	if len(labels) > 0 {
+		builder.WriteString("\n**Tags** " + strings.Join(labels, ", ") + "\n")
+	}
+
+	if ingredients := ingredientLines(recipe); len(ingredients) > 0 {
+		builder.WriteString("\n## Ingredients\n\n")
+		for _, line := range ingredients {
+			builder.WriteString("- " + markdownLine(line) + "\n")
+		}
+	}
+
+	if steps := stepLines(recipe); len(steps) > 0 {
+		builder.WriteString("\n## Steps\n\n")
+		for index, line := range steps {
+			builder.WriteString(strconv.Itoa(index+1) + ". " + markdownLine(line) + "\n")
+		}
+	}
+
+	return builder.String()
+}
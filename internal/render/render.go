@@ -0,0 +1,164 @@
+// Package render writes a recipe out in formats other applications read:
+// schema.org JSON-LD for search engines, Markdown for people, and Cooklang for
+// cooking apps.
+//
+// Renderers only read model.FoodRecipe, so anything holding a recipe, such as
+// the recipe endpoint, bulk export or a print view, can use them. Units and
+// servings are rendered as they are, so convert or scale the recipe first.
+package render
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/pkg/errors"
+)
+
+var ErrUnknownFormat = errors.New("unknown format")
+
+type Format string
+
+const (
+	JSONLD   Format = "jsonld"
+	Markdown Format = "markdown"
+	Cooklang Format = "cooklang"
+)
+
+// Formats lists every format, in the order they are offered.
+var Formats = []Format{JSONLD, Markdown, Cooklang}
+
+// MediaType is what a client asks for in Accept to get the format.
+func (format Format) MediaType() string {
+	switch format {
+	case JSONLD:
+		return "application/ld+json"
+	case Markdown:
+		return "text/markdown"
+	case Cooklang:
+		return "text/x-cooklang"
+	}
+	return ""
+}
+
+// ContentType is what a response in the format is sent as. Cooklang has no
+// registered type, so it is sent as text for browsers to show.
+func (format Format) ContentType() string {
+	switch format {
+	case JSONLD:
+		return "application/ld+json; charset=utf-8"
+	case Markdown:
+		return "text/markdown; charset=utf-8"
+	case Cooklang:
+		return "text/plain; charset=utf-8"
+	}
+	return ""
+}
+
+// Extension names files of the format.
+func (format Format) Extension() string {
+	switch format {
+	case JSONLD:
+		return ".jsonld"
+	case Markdown:
+		return ".md"
+	case Cooklang:
+		return ".cook"
+	}
+	return ""
+}
+
+// ForMediaType returns the format sent as the media type, if any.
+func ForMediaType(mediaType string) (Format, bool) {
+	for _, format := range Formats {
+		if format.MediaType() == mediaType {
+			return format, true
+		}
+	}
+	return "", false
+}
+
+// Render writes the recipe in the format.
+func Render(format Format, recipe model.FoodRecipe) ([]byte, error) {
+	switch format {
+	case JSONLD:
+		return RecipeJSONLD(recipe)
+	case Markdown:
+		return []byte(RecipeMarkdown(recipe)), nil
+	case Cooklang:
+		return []byte(RecipeCooklang(recipe)), nil
+	}
+	return nil, errors.Wrap(ErrUnknownFormat, string(format))
+}
+
+// ingredientLines lists the ingredients as text, falling back to the lines of
+// the legacy text for recipes without structured ingredients.
+func ingredientLines(recipe model.FoodRecipe) []string {
+	if len(recipe.Ingredients) > 0 {
+		lines := make([]string, 0, len(recipe.Ingredients))
+		for _, ingredient := range recipe.Ingredients {
+			lines = append(lines, ingredient.String())
+		}
+		return lines
+	}
+	return lines(recipe.Ingredient)
+}
+
+// stepLines lists the steps as text, falling back to the lines of the legacy
+// text for recipes without structured steps.
+func stepLines(recipe model.FoodRecipe) []string {
+	if len(recipe.Steps) > 0 {
+		lines := make([]string, 0, len(recipe.Steps))
+		for _, step := range recipe.Steps {
+			lines = append(lines, step.Text)
+		}
+		return lines
+	}
+	return lines(recipe.Instruction)
+}
+
+func lines(text string) []string {
+	var results []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			results = append(results, line)
+		}
+	}
+	return results
+}
+
+func formatQuantity(quantity float64) string {
+	return strconv.FormatFloat(quantity, 'f', -1, 64)
+}
+
+// authorName is the display name of the author, empty when unknown.
+func authorName(user model.User) string {
+	return strings.TrimSpace(user.FirstName + " " + user.LastName)
+}
+
+// diets lists the dietary flags of the recipe by their names.
+func diets(recipe model.FoodRecipe) []string {
+	var results []string
+	for _, diet := range []struct {
+		name string
+		ok   bool
+	}{
+		{"vegetarian", recipe.Dietary.Vegetarian},
+		{"vegan", recipe.Dietary.Vegan},
+		{"halal-friendly", recipe.Dietary.HalalFriendly},
+		{"gluten-free", recipe.Dietary.GlutenFree},
+	} {
+		if diet.ok {
+			results = append(results, diet.name)
+		}
+	}
+	return results
+}
+
+func tagNames(recipe model.FoodRecipe) []string {
+	names := make([]string, 0, len(recipe.Tags))
+	for _, tag := range recipe.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
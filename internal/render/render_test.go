@@ -0,0 +1,217 @@
+package render_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/nutrition"
+	"github.com/klins/devpool/go-day6/wongnok/internal/render"
+	"github.com/klins/devpool/go-day6/wongnok/internal/schemaorg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func quantity(value float64) *float64 {
+	return &value
+}
+
+func seconds(value int) *int {
+	return &value
+}
+
+func padKraPao() model.FoodRecipe {
+	imageURL := "https://example.com/pad-kra-pao.jpg"
+	created := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	return model.FoodRecipe{
+		Model:       gorm.Model{ID: 7, CreatedAt: created, UpdatedAt: created.AddDate(0, 0, 3)},
+		Name:        "Pad Kra Pao",
+		Description: "Stir-fried pork with holy basil.",
+		Ingredients: model.RecipeIngredients{
+			{Name: "minced pork", Quantity: quantity(300), Unit: "g", Position: 1},
+			{Name: "fish sauce", Quantity: quantity(1), Unit: "tbsp", Position: 2},
+			{Name: "fish", Quantity: quantity(1), Position: 3},
+			{Name: "holy basil", Quantity: quantity(1), Unit: "cup", Note: "leaves only", Position: 4},
+			{Name: "rice", Position: 5},
+		},
+		Steps: model.RecipeSteps{
+			{Position: 1, Text: "Fry the garlic in oil.", DurationSeconds: seconds(60)},
+			{Position: 2, Text: "Add the minced pork and the fish sauce.", DurationSeconds: seconds(90)},
+			{Position: 3, Text: "Fold in the holy basil, serve with #1 rice @ home."},
+		},
+		ImageURL:        &imageURL,
+		Servings:        2,
+		CookingDuration: model.CookingDuration{Name: "11 - 30"},
+		Difficulty:      model.Difficulty{Name: "Easy"},
+		Tags:            model.Tags{{Name: "Thai"}, {Name: "Stir-fry"}},
+		Dietary:         model.DietaryFlags{GlutenFree: true},
+		User:            model.User{FirstName: "Somchai", LastName: "Jaidee"},
+		RatingCount:     2,
+		AverageRating:   4.5,
+		Nutrition:       &model.Nutrition{Servings: 2, PerServing: nutrition.Nutrients{Calories: 512.34, Protein: 30, Fat: 25.55, Carbs: 40, Sodium: 900}},
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Run("ShouldReturnErrorForUnknownFormat", func(t *testing.T) {
+		_, err := render.Render("pdf", padKraPao())
+		assert.ErrorIs(t, err, render.ErrUnknownFormat)
+	})
+
+	t.Run("ShouldFindFormatByMediaType", func(t *testing.T) {
+		format, ok := render.ForMediaType("text/markdown")
+		assert.True(t, ok)
+		assert.Equal(t, render.Markdown, format)
+
+		_, ok = render.ForMediaType("application/json")
+		assert.False(t, ok)
+	})
+}
+
+func TestRecipeJSONLD(t *testing.T) {
+	t.Run("ShouldWriteSchemaOrgRecipe", func(t *testing.T) {
+		document, err := render.RecipeJSONLD(padKraPao())
+		require.NoError(t, err)
+
+		var value map[string]any
+		require.NoError(t, json.Unmarshal(document, &value))
+
+		assert.Equal(t, "https://schema.org", value["@context"])
+		assert.Equal(t, "Recipe", value["@type"])
+		assert.Equal(t, "2026-10-01", value["datePublished"])
+		assert.Equal(t, "2026-10-04", value["dateModified"])
+		assert.Equal(t, "2", value["recipeYield"])
+		assert.Equal(t, "Thai, Stir-fry", value["keywords"])
+		assert.Equal(t, []any{"https://schema.org/GlutenFreeDiet"}, value["suitableForDiet"])
+		assert.Equal(t, map[string]any{"@type": "Person", "name": "Somchai Jaidee"}, value["author"])
+		assert.Equal(t, "512.3 kcal", value["nutrition"].(map[string]any)["calories"])
+		assert.Equal(t, "25.6 g", value["nutrition"].(map[string]any)["fatContent"])
+		assert.Equal(t, 4.5, value["aggregateRating"].(map[string]any)["ratingValue"])
+		assert.Equal(t, 2.0, value["aggregateRating"].(map[string]any)["ratingCount"])
+	})
+
+	t.Run("ShouldImportBackAsTheSameRecipe", func(t *testing.T) {
+		document, err := render.RecipeJSONLD(padKraPao())
+		require.NoError(t, err)
+
+		recipe, err := schemaorg.Parse(document)
+		require.NoError(t, err)
+
+		assert.Equal(t, schemaorg.Recipe{
+			Name:        "Pad Kra Pao",
+			Description: "Stir-fried pork with holy basil.",
+			Ingredients: []string{"300 g minced pork", "1 tbsp fish sauce", "1 fish", "1 cup holy basil (leaves only)", "rice"},
+			Instructions: []string{
+				"Fry the garlic in oil.",
+				"Add the minced pork and the fish sauce.",
+				"Fold in the holy basil, serve with #1 rice @ home.",
+			},
+			Yield:    2,
+			ImageURL: "https://example.com/pad-kra-pao.jpg",
+		}, recipe)
+	})
+
+	t.Run("ShouldFallBackToLegacyText", func(t *testing.T) {
+		document, err := render.RecipeJSONLD(model.FoodRecipe{Name: "Legacy", Ingredient: "rice\n\neggs", Instruction: "Cook.\nEat."})
+		require.NoError(t, err)
+
+		recipe, err := schemaorg.Parse(document)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"rice", "eggs"}, recipe.Ingredients)
+		assert.Equal(t, []string{"Cook.", "Eat."}, recipe.Instructions)
+	})
+}
+
+func TestRecipeMarkdown(t *testing.T) {
+	t.Run("ShouldWriteDocument", func(t *testing.T) {
+		assert.Equal(t, `# Pad Kra Pao
+
+![Pad Kra Pao](<https://example.com/pad-kra-pao.jpg>)
+
+Stir-fried pork with holy basil.
+
+**By** Somchai Jaidee · **Servings** 2 · **Time** 11 - 30 min · **Difficulty** Easy
+
+**Tags** Thai, Stir-fry, gluten-free
+
+## Ingredients
+
+- 300 g minced pork
+- 1 tbsp fish sauce
+- 1 fish
+- 1 cup holy basil (leaves only)
+- rice
+
+## Steps
+
+1. Fry the garlic in oil.
+2. Add the minced pork and the fish sauce.
+3. Fold in the holy basil, serve with #1 rice @ home.
+`, render.RecipeMarkdown(padKraPao()))
+	})
+
+	t.Run("ShouldEscapeMarkdownWrittenByAuthor", func(t *testing.T) {
+		markdown := render.RecipeMarkdown(model.FoodRecipe{
+			Name:        "*Best* [rice]",
+			Instruction: "# Wash the rice\n1. Cook it\n1.5 cups of water",
+		})
+
+		assert.Equal(t, `# \*Best\* \[rice\]
+
+## Steps
+
+1. \# Wash the rice
+2. 1\. Cook it
+3. 1.5 cups of water
+`, markdown)
+	})
+}
+
+func TestRecipeCooklang(t *testing.T) {
+	t.Run("ShouldMarkIngredientsWhereFirstNamed", func(t *testing.T) {
+		assert.Equal(t, `>> title: Pad Kra Pao
+>> description: Stir-fried pork with holy basil.
+>> author: Somchai Jaidee
+>> tags: Thai, Stir-fry, gluten-free
+>> servings: 2
+>> image: https://example.com/pad-kra-pao.jpg
+
+@fish{1}
+
+Fry the garlic in oil. ~{1%minutes}
+
+Add the @minced pork{300%g} and the @fish sauce{1%tbsp}. ~{90%seconds}
+
+Fold in the @holy basil{1%cup}(leaves only), serve with \#1 @rice{} \@ home.
+`, render.RecipeCooklang(padKraPao()))
+	})
+
+	t.Run("ShouldMarkWholeWordsOnly", func(t *testing.T) {
+		recipe := model.FoodRecipe{
+			Ingredients: model.RecipeIngredients{
+				{Name: "egg", Quantity: quantity(2)},
+				{Name: "น้ำ", Quantity: quantity(2), Unit: "ถ้วย"},
+			},
+			Steps: model.RecipeSteps{
+				{Position: 1, Text: "Slice the eggplant, then beat the Eggs."},
+				{Position: 2, Text: "ตั้งน้ำมันให้ร้อน แล้วเติมน้ำ"},
+			},
+		}
+
+		assert.Equal(t, "Slice the eggplant, then beat the @egg{2}.\n\nตั้งน้ำมันให้ร้อน แล้วเติม@น้ำ{2%ถ้วย}\n", render.RecipeCooklang(recipe))
+	})
+
+	t.Run("ShouldKeepLegacyIngredientsAsNotes", func(t *testing.T) {
+		assert.Equal(t, `>> title: Legacy
+
+> 2 cups rice
+> 1 egg
+
+Cook the rice.
+`, render.RecipeCooklang(model.FoodRecipe{Name: "Legacy", Ingredient: "2 cups rice\n1 egg", Instruction: "Cook the rice."}))
+	})
+}
@@ -0,0 +1,36 @@
+package schemaorg
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var isoDuration = regexp.MustCompile(`(?i)^P(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// ParseDuration reads an ISO 8601 duration as schema.org uses them for times,
+// e.g. "PT1H30M" or "P0DT45M". Years, months and weeks are not used for
+// cooking and are not accepted. An empty text is no duration.
+func ParseDuration(text string) (time.Duration, error) {
+	if text == "" {
+		return 0, nil
+	}
+
+	match := isoDuration.FindStringSubmatch(text)
+	if match == nil || text == "P" || text == "PT" {
+		return 0, errors.Errorf("invalid duration %q", text)
+	}
+
+	var duration time.Duration
+	for index, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if match[index+1] == "" {
+			continue
+		}
+		amount, _ := strconv.ParseFloat(match[index+1], 64)
+		duration += time.Duration(amount * float64(unit))
+	}
+
+	return duration, nil
+}
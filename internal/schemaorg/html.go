@@ -0,0 +1,46 @@
+package schemaorg
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// scripts returns the contents of the JSON-LD scripts of an HTML page, in
+// page order.
+func scripts(page []byte) [][]byte {
+	var results [][]byte
+
+	tokenizer := html.NewTokenizer(bytes.NewReader(page))
+	inScript := false
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return results
+		case html.StartTagToken:
+			name, hasAttributes := tokenizer.TagName()
+			inScript = string(name) == "script" && hasAttributes && isJSONLD(tokenizer)
+		case html.TextToken:
+			if inScript {
+				results = append(results, bytes.Clone(tokenizer.Text()))
+			}
+		case html.EndTagToken:
+			inScript = false
+		}
+	}
+}
+
+// isJSONLD reads the attributes of a script tag for type="application/ld+json".
+func isJSONLD(tokenizer *html.Tokenizer) bool {
+	for {
+		key, value, more := tokenizer.TagAttr()
+		if string(key) == "type" {
+			mediaType, _, _ := strings.Cut(string(value), ";")
+			return strings.EqualFold(strings.TrimSpace(mediaType), "application/ld+json")
+		}
+		if !more {
+			return false
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Package schemaorg reads the schema.org Recipe that recipe sites publish as
+// JSON-LD for search engines, either from the JSON-LD document itself or from
+// the HTML page embedding it.
+//
+// Sites write the same properties in several shapes, a string or a list, a
+// plain step or a HowToStep nested in a HowToSection, so every property is
+// read leniently and flattened into plain text.
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidDocument = errors.New("invalid JSON-LD document")
+	ErrNoRecipe        = errors.New("no schema.org Recipe found")
+)
+
+// Recipe is what a schema.org Recipe holds that a recipe here can use.
+type Recipe struct {
+	Name         string
+	Description  string
+	Ingredients  []string
+	Instructions []string // steps in order, sections flattened
+	TotalTime    time.Duration
+	Yield        int // servings, zero when not stated
+	ImageURL     string
+}
+
+// Parse reads the first Recipe in a JSON-LD document or in the JSON-LD
+// scripts of an HTML page.
+func Parse(document []byte) (Recipe, error) {
+	document = bytes.TrimSpace(bytes.TrimPrefix(document, []byte("\xef\xbb\xbf")))
+
+	if len(document) > 0 && (document[0] == '{' || document[0] == '[') {
+		var value any
+		if err := json.Unmarshal(document, &value); err != nil {
+			return Recipe{}, errors.Wrap(ErrInvalidDocument, err.Error())
+		}
+
+		if node, ok := findRecipe(value); ok {
+			return readRecipe(node), nil
+		}
+		return Recipe{}, ErrNoRecipe
+	}
+
+	for _, script := range scripts(document) {
+		var value any
+		if err := json.Unmarshal(script, &value); err != nil {
+			// One broken script does not stop the others being read
+			continue
+		}
+
+		if node, ok := findRecipe(value); ok {
+			return readRecipe(node), nil
+		}
+	}
+	return Recipe{}, ErrNoRecipe
+}
+
+// findRecipe looks for a Recipe node in a list, a @graph or the mainEntity
+// of a page.
+func findRecipe(value any) (map[string]any, bool) {
+	switch value := value.(type) {
+	case []any:
+		for _, item := range value {
+			if node, ok := findRecipe(item); ok {
+				return node, true
+			}
+		}
+	case map[string]any:
+		if hasType(value, "Recipe") {
+			return value, true
+		}
+		for _, key := range []string{"@graph", "mainEntity", "mainEntityOfPage"} {
+			if node, ok := findRecipe(value[key]); ok {
+				return node, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// hasType tells whether a node is of the type, which @type may give alone or
+// among others, with or without the schema.org prefix.
+func hasType(node map[string]any, name string) bool {
+	for _, value := range list(node["@type"]) {
+		text, _ := value.(string)
+		text = strings.TrimPrefix(strings.TrimPrefix(text, "http://schema.org/"), "https://schema.org/")
+		if text == name {
+			return true
+		}
+	}
+	return false
+}
+
+func readRecipe(node map[string]any) Recipe {
+	recipe := Recipe{
+		Name:         text(node["name"]),
+		Description:  text(node["description"]),
+		Instructions: instructions(node["recipeInstructions"]),
+		Yield:        yield(node["recipeYield"]),
+		ImageURL:     image(node["image"]),
+	}
+
+	for _, value := range list(node["recipeIngredient"]) {
+		if ingredient := text(value); ingredient != "" {
+			recipe.Ingredients = append(recipe.Ingredients, ingredient)
+		}
+	}
+	// recipeIngredient replaced ingredients, which older pages still use
+	if len(recipe.Ingredients) == 0 {
+		for _, value := range list(node["ingredients"]) {
+			if ingredient := text(value); ingredient != "" {
+				recipe.Ingredients = append(recipe.Ingredients, ingredient)
+			}
+		}
+	}
+
+	recipe.TotalTime, _ = ParseDuration(text(node["totalTime"]))
+	if recipe.TotalTime == 0 {
+		prep, _ := ParseDuration(text(node["prepTime"]))
+		cook, _ := ParseDuration(text(node["cookTime"]))
+		recipe.TotalTime = prep + cook
+	}
+
+	return recipe
+}
+
+// list returns a property as a list, whether it was written as one or not.
+func list(value any) []any {
+	switch value := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		return value
+	default:
+		return []any{value}
+	}
+}
+
+var (
+	breaks = regexp.MustCompile(`(?i)<br\s*/?>|</(?:p|div|li|h[1-6])>`)
+	tags   = regexp.MustCompile(`<[^>]*>`)
+	spaces = regexp.MustCompile(`[ \t\r\f\v]+`)
+)
+
+// text reads a property as plain text. Sites often leave markup and entities
+// in their JSON-LD, so both are removed, keeping the line breaks of block
+// elements.
+func text(value any) string {
+	var result string
+	switch value := value.(type) {
+	case string:
+		result = value
+	case float64:
+		result = strconv.FormatFloat(value, 'f', -1, 64)
+	case []any:
+		if len(value) > 0 {
+			return text(value[0])
+		}
+	case map[string]any:
+		return text(value["@value"])
+	}
+
+	result = breaks.ReplaceAllString(result, "\n")
+	result = html.UnescapeString(tags.ReplaceAllString(result, ""))
+	lines := strings.Split(result, "\n")
+	for index, line := range lines {
+		lines[index] = strings.TrimSpace(spaces.ReplaceAllString(line, " "))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// instructions flattens recipeInstructions into steps. It may be one text
+// with a step per line, a list of texts, HowToSteps, or HowToSections of
+// HowToSteps.
+func instructions(value any) []string {
+	var steps []string
+
+	for _, item := range list(value) {
+		switch item := item.(type) {
+		case string:
+			for _, line := range strings.Split(text(item), "\n") {
+				if line != "" {
+					steps = append(steps, line)
+				}
+			}
+		case map[string]any:
+			// HowToSection, or a HowToStep split into HowToDirections
+			if elements, ok := item["itemListElement"]; ok {
+				steps = append(steps, instructions(elements)...)
+				continue
+			}
+
+			step := text(item["text"])
+			if step == "" {
+				step = text(item["name"])
+			}
+			if step != "" {
+				steps = append(steps, step)
+			}
+		}
+	}
+
+	return steps
+}
+
+var number = regexp.MustCompile(`\d+`)
+
+// yield reads the servings from recipeYield, e.g. 4, "4", "4 servings" or
+// ["4", "4 servings"].
+func yield(value any) int {
+	for _, item := range list(value) {
+		if match := number.FindString(text(item)); match != "" {
+			servings, _ := strconv.Atoi(match)
+			return servings
+		}
+	}
+	return 0
+}
+
+// image reads the first image URL, given as a URL, an ImageObject or a list
+// of either.
+func image(value any) string {
+	for _, item := range list(value) {
+		var url string
+		switch item := item.(type) {
+		case string:
+			url = item
+		case map[string]any:
+			url = text(item["url"])
+			if url == "" {
+				url = text(item["contentUrl"])
+			}
+		}
+
+		if url = strings.TrimSpace(url); url != "" {
+			return url
+		}
+	}
+	return ""
+}
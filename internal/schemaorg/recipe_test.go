@@ -0,0 +1,137 @@
+package schemaorg_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/schemaorg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixture(t *testing.T, name string) []byte {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+func TestParse(t *testing.T) {
+	t.Run("ShouldReadHowToSteps", func(t *testing.T) {
+		recipe, err := schemaorg.Parse(fixture(t, "howto-steps.json"))
+		require.NoError(t, err)
+
+		assert.Equal(t, schemaorg.Recipe{
+			Name:        "Pad Kra Pao",
+			Description: "Stir-fried pork with holy basil, served over rice with a fried egg.",
+			Ingredients: []string{
+				"300 g minced pork",
+				"2 tbsp oyster sauce",
+				"1 tbsp fish sauce",
+				"1 cup holy basil leaves",
+				"2 eggs",
+			},
+			Instructions: []string{
+				"Pound the garlic and chillies, then fry them in oil until fragrant.",
+				"Add the pork and stir-fry until cooked through.",
+				"Season with the sauces and fold in the basil.",
+			},
+			TotalTime: 25 * time.Minute,
+			Yield:     2,
+			ImageURL:  "https://example.com/images/pad-kra-pao.jpg",
+		}, recipe)
+	})
+
+	t.Run("ShouldFlattenHowToSectionsFromHTMLGraph", func(t *testing.T) {
+		recipe, err := schemaorg.Parse(fixture(t, "sections.html"))
+		require.NoError(t, err)
+
+		assert.Equal(t, schemaorg.Recipe{
+			Name:        "Green Curry & Roti",
+			Description: "A weeknight green curry.",
+			Ingredients: []string{
+				"400 ml coconut milk",
+				"3 tbsp green curry paste",
+				"500 g chicken thighs, sliced",
+			},
+			Instructions: []string{
+				"Fry the paste in the coconut cream until the oil splits.",
+				"Add the chicken and the rest of the coconut milk.",
+				"Warm the roti in a dry pan.",
+			},
+			TotalTime: 80 * time.Minute,
+			Yield:     4,
+			ImageURL:  "https://example.com/images/green-curry-16x9.jpg",
+		}, recipe)
+	})
+
+	t.Run("ShouldReadStringStepsSkippingOtherScripts", func(t *testing.T) {
+		recipe, err := schemaorg.Parse(fixture(t, "string-steps.html"))
+		require.NoError(t, err)
+
+		assert.Equal(t, schemaorg.Recipe{
+			Name:        "ต้มยำกุ้ง",
+			Description: "ต้มยำกุ้งน้ำข้น รสจัดจ้าน",
+			Ingredients: []string{"กุ้งแม่น้ำ 500 กรัม", "ตะไคร้ 2 ต้น", "น้ำปลา 3 ช้อนโต๊ะ"},
+			Instructions: []string{
+				"ต้มน้ำให้เดือด ใส่ตะไคร้ ข่า และใบมะกรูด",
+				"ใส่กุ้ง พอกุ้งสุกปิดไฟ",
+				"ปรุงรสด้วยน้ำปลาและน้ำมะนาว",
+			},
+			TotalTime: 40 * time.Minute,
+			Yield:     3,
+			ImageURL:  "https://example.com/images/tom-yum.jpg",
+		}, recipe)
+	})
+
+	t.Run("ShouldSplitTextStepsByLine", func(t *testing.T) {
+		recipe, err := schemaorg.Parse(fixture(t, "text-steps.json"))
+		require.NoError(t, err)
+
+		assert.Equal(t, schemaorg.Recipe{
+			Name:        "Mango Sticky Rice",
+			Ingredients: []string{"1 cup glutinous rice", "1 ripe mango"},
+			Instructions: []string{
+				"Soak the rice overnight.",
+				"Steam the rice for 25 minutes.",
+				"Pour the sweetened coconut milk over the rice.",
+			},
+			TotalTime: 90 * time.Minute,
+		}, recipe)
+	})
+
+	t.Run("ShouldReturnErrorWhenNoRecipe", func(t *testing.T) {
+		_, err := schemaorg.Parse(fixture(t, "no-recipe.html"))
+		assert.ErrorIs(t, err, schemaorg.ErrNoRecipe)
+	})
+
+	t.Run("ShouldReturnErrorWhenJSONInvalid", func(t *testing.T) {
+		_, err := schemaorg.Parse([]byte(`{"@type": "Recipe",`))
+		assert.ErrorIs(t, err, schemaorg.ErrInvalidDocument)
+	})
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"":           0,
+		"PT45M":      45 * time.Minute,
+		"PT1H30M":    90 * time.Minute,
+		"P0DT0H40M":  40 * time.Minute,
+		"P1D":        24 * time.Hour,
+		"PT0.5H":     30 * time.Minute,
+		"pt2h":       2 * time.Hour,
+		"PT1H20M30S": 80*time.Minute + 30*time.Second,
+	}
+
+	for text, expected := range cases {
+		duration, err := schemaorg.ParseDuration(text)
+		assert.NoError(t, err, text)
+		assert.Equal(t, expected, duration, text)
+	}
+
+	for _, text := range []string{"P", "PT", "45 minutes", "P1Y"} {
+		_, err := schemaorg.ParseDuration(text)
+		assert.Error(t, err, text)
+	}
+}
@@ -0,0 +1,248 @@
+// Package search turns recipe text into the space separated tokens stored in
+// the full-text search columns, and search input into a tsquery.
+//
+// PostgreSQL cannot split Thai, which is written without spaces between
+// words, so Thai runs are segmented here with the dictionary in words.txt
+// before the text reaches the database.
+package search
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+//go:embed words.txt
+var wordsTxt []byte
+
+var (
+	dictionary    = make(map[string]bool)
+	maxWordLength int
+)
+
+func init() {
+	scanner := bufio.NewScanner(bytes.NewReader(wordsTxt))
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+
+		dictionary[word] = true
+		if length := len([]rune(word)); length > maxWordLength {
+			maxWordLength = length
+		}
+	}
+}
+
+// Tokenize lowercases the text and splits it into words. Thai runs are
+// segmented with the dictionary; everything else splits on anything that is
+// not a letter or digit.
+func Tokenize(text string) []string {
+	var tokens []string
+	for _, span := range Spans(text) {
+		tokens = append(tokens, strings.ToLower(text[span[0]:span[1]]))
+	}
+	return tokens
+}
+
+// Spans returns where each word Tokenize finds starts and ends in text, as
+// byte offsets.
+func Spans(text string) [][2]int {
+	var (
+		spans [][2]int
+		start = -1
+		thai  bool
+	)
+
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		if thai {
+			offset := start
+			for _, word := range segment([]rune(text[start:end])) {
+				spans = append(spans, [2]int{offset, offset + len(word)})
+				offset += len(word)
+			}
+		} else {
+			spans = append(spans, [2]int{start, end})
+		}
+		start = -1
+	}
+
+	for index, r := range text {
+		isThai := unicode.Is(unicode.Thai, r)
+		if !isThai && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush(index)
+			continue
+		}
+		if start >= 0 && isThai != thai {
+			flush(index)
+		}
+		if start < 0 {
+			start = index
+		}
+		thai = isThai
+	}
+	flush(len(text))
+
+	return spans
+}
+
+// Document joins the tokens of every text, ready for to_tsvector('simple').
+func Document(texts ...string) string {
+	var tokens []string
+	for _, text := range texts {
+		tokens = append(tokens, Tokenize(text)...)
+	}
+	return strings.Join(tokens, " ")
+}
+
+// Query builds a to_tsquery('simple') expression matching every word of the
+// input, treating the last one as a prefix so results show up while typing.
+// It returns an empty string when the input has no words.
+func Query(text string) string {
+	tokens := Tokenize(text)
+	if len(tokens) == 0 {
+		return ""
+	}
+
+	terms := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		// Tokens only hold letters and digits, so quoting is enough
+		terms = append(terms, "'"+token+"'")
+	}
+	terms[len(terms)-1] += ":*"
+
+	return strings.Join(terms, " & ")
+}
+
+// Name splits an ingredient name into the space separated words it is matched
+// by, so "egg" matches "eggs" but not "eggplant", and "น้ำ" not "น้ำปลา".
+// English plurals are folded into the singular.
+func Name(text string) string {
+	tokens := Tokenize(text)
+	for index, token := range tokens {
+		tokens[index] = singular(token)
+	}
+	return strings.Join(tokens, " ")
+}
+
+// singular folds the common English plurals, "eggs" and "tomatoes". Words
+// ending in "ss" are left alone.
+func singular(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "oes"):
+		return strings.TrimSuffix(word, "es")
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+// IsHead tells whether key, split into words, names what the words name: it
+// ends an English name, as in "jasmine rice" but not "rice vinegar", and
+// starts a Thai one, as in "นมสด" but not "ขนมปัง".
+func IsHead(words []string, key []string) bool {
+	if len(key) == 0 || len(key) > len(words) {
+		return false
+	}
+
+	if first := []rune(key[0])[0]; unicode.Is(unicode.Thai, first) {
+		return slices.Equal(words[:len(key)], key)
+	}
+	return slices.Equal(words[len(words)-len(key):], key)
+}
+
+// canBreak reports whether a word may end before runes[index]. Thai never
+// breaks before a vowel or tone mark that belongs to the previous consonant,
+// nor after a vowel written before its consonant.
+func canBreak(runes []rune, index int) bool {
+	if index == 0 || index == len(runes) {
+		return true
+	}
+
+	switch next := runes[index]; {
+	case next == 'ะ' || next == 'า' || next == 'ำ' || next == 'ๅ':
+		return false
+	case next == '\u0E31' || (next >= '\u0E34' && next <= '\u0E3A') || (next >= '\u0E47' && next <= '\u0E4E'):
+		return false
+	}
+
+	previous := runes[index-1]
+	return previous < 'เ' || previous > 'ไ'
+}
+
+// segment splits a run of Thai with maximal matching: the split with the
+// fewest characters outside the dictionary, then the fewest words, wins.
+// Neighbouring unknown characters are kept together as one token.
+func segment(runes []rune) []string {
+	type step struct {
+		unknown int
+		words   int
+		from    int
+		known   bool
+		reached bool
+	}
+
+	best := make([]step, len(runes)+1)
+	best[0].reached = true
+
+	better := func(candidate, current step) bool {
+		if !current.reached {
+			return true
+		}
+		if candidate.unknown != current.unknown {
+			return candidate.unknown < current.unknown
+		}
+		return candidate.words < current.words
+	}
+
+	for start := 0; start < len(runes); start++ {
+		if !best[start].reached {
+			continue
+		}
+
+		for length := 1; length <= maxWordLength && start+length <= len(runes); length++ {
+			if !canBreak(runes, start) || !canBreak(runes, start+length) || !dictionary[string(runes[start:start+length])] {
+				continue
+			}
+
+			candidate := step{unknown: best[start].unknown, words: best[start].words + 1, from: start, known: true, reached: true}
+			if better(candidate, best[start+length]) {
+				best[start+length] = candidate
+			}
+		}
+
+		candidate := step{unknown: best[start].unknown + 1, words: best[start].words + 1, from: start, reached: true}
+		if better(candidate, best[start+1]) {
+			best[start+1] = candidate
+		}
+	}
+
+	var tokens []string
+	end := len(runes)
+	for end > 0 {
+		start := best[end].from
+
+		// Walk back over consecutive unknown characters as one token
+		if !best[end].known {
+			for start > 0 && !best[start].known {
+				start = best[start].from
+			}
+		}
+
+		tokens = append(tokens, string(runes[start:end]))
+		end = start
+	}
+
+	for i, j := 0, len(tokens)-1; i < j; i, j = i+1, j-1 {
+		tokens[i], tokens[j] = tokens[j], tokens[i]
+	}
+
+	return tokens
+}
@@ -0,0 +1,77 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := map[string]struct {
+		text     string
+		expected []string
+	}{
+		"ShouldSplitThaiWords":          {text: "ต้มยำกุ้งน้ำข้น", expected: []string{"ต้ม", "ยำ", "กุ้ง", "น้ำ", "ข้น"}},
+		"ShouldPreferLongerWords":       {text: "ผัดกะเพราหมูสับไข่ดาว", expected: []string{"ผัด", "กะเพรา", "หมู", "สับ", "ไข่", "ดาว"}},
+		"ShouldKeepUnknownRunTogether":  {text: "ส้มตำปูปลาร้า", expected: []string{"ส้ม", "ตำ", "ปู", "ปลาร้า"}},
+		"ShouldGroupUnknownCharacters":  {text: "แกงฮังเล", expected: []string{"แกง", "ฮังเล"}},
+		"ShouldLowercaseAndSplitLatin":  {text: "Pad Thai, (Bangkok-style)", expected: []string{"pad", "thai", "bangkok", "style"}},
+		"ShouldSplitMixedScripts":       {text: "ไข่เจียวOmelette 2ฟอง", expected: []string{"ไข่", "เจียว", "omelette", "2", "ฟอง"}},
+		"ShouldReturnNothingForNoWords": {text: " - ", expected: nil},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, search.Tokenize(tc.text))
+		})
+	}
+}
+
+func TestSpans(t *testing.T) {
+	text := "Fry ไข่เจียว, then EGGS"
+
+	spans := search.Spans(text)
+	words := make([]string, 0, len(spans))
+	for _, span := range spans {
+		words = append(words, text[span[0]:span[1]])
+	}
+
+	assert.Equal(t, []string{"Fry", "ไข่", "เจียว", "then", "EGGS"}, words)
+}
+
+func TestQuery(t *testing.T) {
+	assert.Equal(t, "'ต้ม' & 'ยำ':*", search.Query("ต้มยำ"))
+	assert.Equal(t, "'pad' & 'tha':*", search.Query("Pad tha'"))
+	assert.Equal(t, "", search.Query("&|!"))
+}
+
+func TestName(t *testing.T) {
+	cases := map[string]struct {
+		text     string
+		expected string
+	}{
+		"ShouldFoldPlurals":          {text: "Eggs", expected: "egg"},
+		"ShouldFoldPluralsInOes":     {text: "Cherry tomatoes", expected: "cherry tomato"},
+		"ShouldKeepWordsInSs":        {text: "Sea bass", expected: "sea bass"},
+		"ShouldKeepCompoundWord":     {text: "eggplant", expected: "eggplant"},
+		"ShouldKeepPrefixedWord":     {text: "unsalted butter", expected: "unsalted butter"},
+		"ShouldKeepThaiSauceWhole":   {text: "น้ำปลา", expected: "น้ำปลา"},
+		"ShouldKeepThaiBreadWhole":   {text: "ขนมปัง", expected: "ขนมปัง"},
+		"ShouldSplitThaiIngredients": {text: "หมูสับ", expected: "หมู สับ"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, search.Name(tc.text))
+		})
+	}
+}
+
+func TestIsHead(t *testing.T) {
+	assert.True(t, search.IsHead(search.Tokenize("jasmine rice"), []string{"rice"}))
+	assert.False(t, search.IsHead(search.Tokenize("rice vinegar"), []string{"rice"}))
+	assert.True(t, search.IsHead(search.Tokenize("นมสด"), []string{"นม"}))
+	assert.False(t, search.IsHead(search.Tokenize("ขนมปัง"), []string{"นม"}))
+	assert.False(t, search.IsHead(search.Tokenize("rice"), []string{"jasmine", "rice"}))
+}
@@ -0,0 +1,107 @@
+// Package shoppinglist generates shopping lists out of recipes and meal plans.
+// Ingredients are merged by name with their amounts summed, and grouped by
+// the supermarket aisle found by the keyword rules in aisles.csv.
+package shoppinglist
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+//go:embed aisles.csv
+var aislesCSV []byte
+
+// Aisles in the order a list is walked through. Ingredients no rule matches
+// go to AisleOther, last.
+var Aisles = []string{
+	"produce",
+	"meat-seafood",
+	"dairy-eggs",
+	"bakery",
+	"pantry",
+	"spices-condiments",
+	"frozen",
+	"beverages",
+	AisleOther,
+}
+
+const AisleOther = "other"
+
+// aisleNames are the headings aisles are exported under.
+var aisleNames = map[string]string{
+	"produce":           "Produce",
+	"meat-seafood":      "Meat & seafood",
+	"dairy-eggs":        "Dairy & eggs",
+	"bakery":            "Bakery",
+	"pantry":            "Pantry",
+	"spices-condiments": "Spices & condiments",
+	"frozen":            "Frozen",
+	"beverages":         "Beverages",
+	AisleOther:          "Other",
+}
+
+type rule struct {
+	keyword string
+	pattern *regexp.Regexp
+	aisle   string
+}
+
+var rules = mustLoad(aislesCSV)
+
+// Aisle returns the aisle of an ingredient. The longest matching keyword
+// wins, so "fish sauce" is a condiment while "fish" is seafood.
+func Aisle(ingredient string) string {
+	name := strings.ToLower(ingredient)
+	for _, rule := range rules {
+		if rule.pattern.MatchString(name) {
+			return rule.aisle
+		}
+	}
+	return AisleOther
+}
+
+// aisleOrder returns where an aisle comes in Aisles.
+func aisleOrder(aisle string) int {
+	for index, candidate := range Aisles {
+		if candidate == aisle {
+			return index
+		}
+	}
+	return len(Aisles)
+}
+
+func mustLoad(data []byte) []rule {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		panic("shoppinglist: read aisles.csv: " + err.Error())
+	}
+
+	results := make([]rule, 0, len(records))
+	for _, record := range records[1:] {
+		keyword := strings.ToLower(record[0])
+		results = append(results, rule{keyword: keyword, pattern: compile(keyword), aisle: record[1]})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return len(results[i].keyword) > len(results[j].keyword)
+	})
+
+	return results
+}
+
+// compile matches English keywords as whole words, plurals included. Thai is
+// written without spaces between words, so Thai keywords match anywhere. See
+// the dietary rules, which match the same way.
+func compile(keyword string) *regexp.Regexp {
+	for _, r := range keyword {
+		if r > unicode.MaxASCII {
+			return regexp.MustCompile(regexp.QuoteMeta(keyword))
+		}
+	}
+	return regexp.MustCompile(`\b` + regexp.QuoteMeta(keyword) + `(?:e?s)?\b`)
+}
@@ -0,0 +1,59 @@
+package shoppinglist
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+)
+
+// Export writes a list as plain text to print or paste into a notes app: the
+// name, then each aisle with its items, checked ones ticked.
+//
+//	Weekend
+//
+//	Produce
+//	- [ ] 3 clove garlic
+//	- [x] 1.5 kg onion
+func Export(list model.ShoppingList) string {
+	var builder strings.Builder
+	builder.WriteString(list.Name)
+	builder.WriteString("\n")
+
+	aisle := ""
+	for _, item := range list.Items {
+		if item.Aisle != aisle || aisle == "" {
+			aisle = item.Aisle
+			builder.WriteString("\n")
+			builder.WriteString(aisleName(aisle))
+			builder.WriteString("\n")
+		}
+
+		box := "[ ]"
+		if item.Checked {
+			box = "[x]"
+		}
+		builder.WriteString("- " + box + " " + itemText(item) + "\n")
+	}
+
+	return builder.String()
+}
+
+func aisleName(aisle string) string {
+	if name, ok := aisleNames[aisle]; ok {
+		return name
+	}
+	return aisle
+}
+
+func itemText(item model.ShoppingListItem) string {
+	var parts []string
+	if item.Quantity != nil {
+		parts = append(parts, strconv.FormatFloat(*item.Quantity, 'f', -1, 64))
+	}
+	if item.Unit != "" {
+		parts = append(parts, item.Unit)
+	}
+	parts = append(parts, item.Name)
+	return strings.Join(parts, " ")
+}
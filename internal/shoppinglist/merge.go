@@ -0,0 +1,161 @@
+package shoppinglist
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+	"github.com/klins/devpool/go-day6/wongnok/internal/units"
+)
+
+// count is a running total of an ingredient in a unit the units package does
+// not know, such as "clove" or "ฟอง", or in no unit at all.
+type count struct {
+	unit     string
+	quantity float64
+}
+
+// total is everything the recipes of a list need of one ingredient. Known
+// units are summed in grams or millilitres.
+type total struct {
+	name       string
+	grams      *float64
+	millilitre *float64
+	counts     []*count
+}
+
+func (total *total) add(ingredient model.RecipeIngredient) {
+	if ingredient.Quantity == nil {
+		return
+	}
+	quantity := *ingredient.Quantity
+
+	if unit, ok := units.Lookup(ingredient.Unit); ok {
+		sum := &total.grams
+		if unit.Dimension == units.Volume {
+			sum = &total.millilitre
+		}
+		if *sum == nil {
+			*sum = new(float64)
+		}
+		**sum += quantity * unit.Factor
+		return
+	}
+
+	for _, count := range total.counts {
+		if strings.EqualFold(count.unit, ingredient.Unit) {
+			count.quantity += quantity
+			return
+		}
+	}
+	total.counts = append(total.counts, &count{unit: ingredient.Unit, quantity: quantity})
+}
+
+// items writes the total out. Volumes are turned into mass when the
+// ingredient is also weighed and its density is known, so "1 cup rice" and
+// "200 g rice" make one item. Metric amounts are written in the most readable
+// unit, e.g. 1.5 kg rather than 1500 g, and rounded to decimals; counts are
+// rounded to kitchen fractions.
+func (total *total) items() model.ShoppingListItems {
+	if total.grams != nil && total.millilitre != nil {
+		if density, ok := units.Density(total.name); ok {
+			*total.grams += *total.millilitre * density
+			total.millilitre = nil
+		}
+	}
+
+	var results model.ShoppingListItems
+	for _, base := range []struct {
+		sum  *float64
+		unit string
+	}{{total.grams, "g"}, {total.millilitre, "ml"}} {
+		if base.sum == nil {
+			continue
+		}
+		quantity, unit, _ := units.ToSystem(*base.sum, base.unit, units.Metric)
+		quantity = roundMetric(quantity, unit)
+		results = append(results, item(total.name, &quantity, unit))
+	}
+
+	for _, count := range total.counts {
+		quantity := foodrecipe.RoundQuantity(count.quantity)
+		results = append(results, item(total.name, &quantity, count.unit))
+	}
+
+	// Only amounts like "to taste" were given
+	if len(results) == 0 {
+		results = append(results, item(total.name, nil, ""))
+	}
+
+	return results
+}
+
+// roundMetric rounds kilograms and litres to two decimals, and grams and
+// millilitres to whole ones unless there are only a few, as in 2.5 g.
+func roundMetric(quantity float64, unit string) float64 {
+	switch {
+	case unit == "kg" || unit == "l":
+		return math.Round(quantity*100) / 100
+	case quantity >= 10:
+		return math.Round(quantity)
+	}
+	return math.Round(quantity*10) / 10
+}
+
+func item(name string, quantity *float64, unit string) model.ShoppingListItem {
+	return model.ShoppingListItem{
+		Name:     name,
+		Quantity: quantity,
+		Unit:     unit,
+		Aisle:    Aisle(name),
+	}
+}
+
+// key is what makes two ingredients the same: the words of their names, as
+// pantries match them, so "Egg" and "eggs" are one item.
+func key(name string) string {
+	return search.Name(name)
+}
+
+// Merge turns the ingredients of every recipe on a list into its items. The
+// same ingredient is summed into one item per kind of unit, and items are
+// ordered by aisle and then by name. Ingredients without a name are skipped.
+func Merge(ingredients model.RecipeIngredients) model.ShoppingListItems {
+	var order []string
+	totals := make(map[string]*total)
+
+	for _, ingredient := range ingredients {
+		name := key(ingredient.Name)
+		if name == "" {
+			continue
+		}
+
+		if totals[name] == nil {
+			totals[name] = &total{name: strings.TrimSpace(ingredient.Name)}
+			order = append(order, name)
+		}
+		totals[name].add(ingredient)
+	}
+
+	results := make(model.ShoppingListItems, 0, len(order))
+	for _, name := range order {
+		results = append(results, totals[name].items()...)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		left, right := aisleOrder(results[i].Aisle), aisleOrder(results[j].Aisle)
+		if left != right {
+			return left < right
+		}
+		return key(results[i].Name) < key(results[j].Name)
+	})
+
+	for index := range results {
+		results[index].Position = index + 1
+	}
+
+	return results
+}
@@ -0,0 +1,148 @@
+package shoppinglist_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/shoppinglist"
+	"github.com/stretchr/testify/assert"
+)
+
+func quantity(value float64) *float64 {
+	return &value
+}
+
+func TestAisle(t *testing.T) {
+	assert.Equal(t, "produce", shoppinglist.Aisle("Red onions"))
+	assert.Equal(t, "meat-seafood", shoppinglist.Aisle("chicken thigh"))
+	assert.Equal(t, "spices-condiments", shoppinglist.Aisle("fish sauce"), "longest keyword wins over fish")
+	assert.Equal(t, "spices-condiments", shoppinglist.Aisle("น้ำปลา"))
+	assert.Equal(t, "meat-seafood", shoppinglist.Aisle("ปลาทู"))
+	assert.Equal(t, "dairy-eggs", shoppinglist.Aisle("ไข่ไก่"), "chicken eggs are eggs")
+	assert.Equal(t, shoppinglist.AisleOther, shoppinglist.Aisle("unobtainium"))
+}
+
+func TestMerge(t *testing.T) {
+	t.Run("sum the same ingredient in units of one dimension", func(t *testing.T) {
+		items := shoppinglist.Merge(model.RecipeIngredients{
+			{Name: "Onion", Quantity: quantity(800), Unit: "g"},
+			{Name: "onion ", Quantity: quantity(0.7), Unit: "kg"},
+		})
+
+		assert.Equal(t, model.ShoppingListItems{
+			{Name: "Onion", Quantity: quantity(1.5), Unit: "kg", Aisle: "produce", Position: 1},
+		}, items)
+	})
+
+	t.Run("turn volume into mass when the density is known", func(t *testing.T) {
+		items := shoppinglist.Merge(model.RecipeIngredients{
+			{Name: "rice", Quantity: quantity(200), Unit: "g"},
+			{Name: "rice", Quantity: quantity(100), Unit: "ml"},
+		})
+
+		assert.Equal(t, model.ShoppingListItems{
+			{Name: "rice", Quantity: quantity(285), Unit: "g", Aisle: "pantry", Position: 1},
+		}, items)
+	})
+
+	t.Run("keep volume and mass apart without a density", func(t *testing.T) {
+		items := shoppinglist.Merge(model.RecipeIngredients{
+			{Name: "basil", Quantity: quantity(20), Unit: "g"},
+			{Name: "basil", Quantity: quantity(2), Unit: "tbsp"},
+		})
+
+		assert.Len(t, items, 2)
+		assert.Equal(t, "g", items[0].Unit)
+		assert.Equal(t, "ml", items[1].Unit)
+		assert.Equal(t, 30.0, *items[1].Quantity)
+	})
+
+	t.Run("sum unknown units by name", func(t *testing.T) {
+		items := shoppinglist.Merge(model.RecipeIngredients{
+			{Name: "garlic", Quantity: quantity(3), Unit: "clove"},
+			{Name: "garlic", Quantity: quantity(2), Unit: "Clove"},
+			{Name: "egg", Quantity: quantity(2)},
+			{Name: "egg", Quantity: quantity(1)},
+		})
+
+		assert.Equal(t, model.ShoppingListItems{
+			{Name: "garlic", Quantity: quantity(5), Unit: "clove", Aisle: "produce", Position: 1},
+			{Name: "egg", Quantity: quantity(3), Aisle: "dairy-eggs", Position: 2},
+		}, items)
+	})
+
+	t.Run("round metric totals to decimals", func(t *testing.T) {
+		items := shoppinglist.Merge(model.RecipeIngredients{
+			{Name: "pork", Quantity: quantity(1000.0 / 3), Unit: "g"},
+			{Name: "pork", Quantity: quantity(2), Unit: "kg"},
+			{Name: "yeast", Quantity: quantity(7.0 / 3), Unit: "g"},
+			{Name: "stock", Quantity: quantity(1.0 / 3), Unit: "l"},
+		})
+
+		assert.Equal(t, model.ShoppingListItems{
+			{Name: "pork", Quantity: quantity(2.33), Unit: "kg", Aisle: "meat-seafood", Position: 1},
+			{Name: "stock", Quantity: quantity(333), Unit: "ml", Aisle: shoppinglist.AisleOther, Position: 2},
+			{Name: "yeast", Quantity: quantity(2.3), Unit: "g", Aisle: shoppinglist.AisleOther, Position: 3},
+		}, items)
+	})
+
+	t.Run("merge singular and plural names", func(t *testing.T) {
+		items := shoppinglist.Merge(model.RecipeIngredients{
+			{Name: "Eggs", Quantity: quantity(2)},
+			{Name: "egg", Quantity: quantity(1)},
+			{Name: "tomatoes", Quantity: quantity(3)},
+			{Name: "Tomato", Quantity: quantity(1)},
+		})
+
+		assert.Len(t, items, 2)
+		assert.Equal(t, "tomatoes", items[0].Name)
+		assert.Equal(t, 4.0, *items[0].Quantity)
+		assert.Equal(t, "Eggs", items[1].Name)
+		assert.Equal(t, 3.0, *items[1].Quantity)
+	})
+
+	t.Run("keep an ingredient without amount once", func(t *testing.T) {
+		items := shoppinglist.Merge(model.RecipeIngredients{
+			{Name: "salt"},
+			{Name: "Salt"},
+			{Name: "  "},
+		})
+
+		assert.Equal(t, model.ShoppingListItems{
+			{Name: "salt", Aisle: "spices-condiments", Position: 1},
+		}, items)
+	})
+
+	t.Run("order by aisle then name", func(t *testing.T) {
+		items := shoppinglist.Merge(model.RecipeIngredients{
+			{Name: "sugar"},
+			{Name: "pork"},
+			{Name: "lime"},
+			{Name: "garlic"},
+		})
+
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		assert.Equal(t, []string{"garlic", "lime", "pork", "sugar"}, names)
+	})
+}
+
+func TestExport(t *testing.T) {
+	list := model.ShoppingList{
+		Name: "Weekend",
+		Items: model.ShoppingListItems{
+			{Name: "garlic", Quantity: quantity(3), Unit: "clove", Aisle: "produce"},
+			{Name: "onion", Quantity: quantity(1.5), Unit: "kg", Aisle: "produce", Checked: true},
+			{Name: "salt", Aisle: "spices-condiments"},
+		},
+	}
+
+	assert.Equal(t, "Weekend\n"+
+		"\nProduce\n"+
+		"- [ ] 3 clove garlic\n"+
+		"- [x] 1.5 kg onion\n"+
+		"\nSpices & condiments\n"+
+		"- [ ] salt\n", shoppinglist.Export(list))
+}
@@ -0,0 +1,87 @@
+package shoppinglist
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Get(userID string, query model.PageQuery) (model.ShoppingLists, pagination.Cursors, error)
+	Count(userID string) (int64, error)
+	GetByID(id string) (model.ShoppingList, error)
+	Create(list *model.ShoppingList) error
+	Delete(id uint) error
+	GetItem(listID uint, itemID string) (model.ShoppingListItem, error)
+	UpdateItem(item *model.ShoppingListItem) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// Get lists the shopping lists of a user without their items, newest first.
+func (repo Repository) Get(userID string, query model.PageQuery) (model.ShoppingLists, pagination.Cursors, error) {
+	var lists = make(model.ShoppingLists, 0)
+
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	limit := query.Size(pagination.DefaultLimit)
+	order := pagination.Order{Table: "shopping_lists", Desc: true}
+
+	err = repo.DB.Scopes(pagination.Scope(order, cursor, limit, query.Offset(limit))).
+		Where("shopping_lists.user_id = ?", userID).
+		Find(&lists).Error
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	lists, cursors := pagination.Paginate(lists, limit, cursor, query.Offset(limit) > 0, func(list model.ShoppingList) pagination.Cursor {
+		return pagination.Cursor{ID: list.ID}
+	})
+	return lists, cursors, nil
+}
+
+func (repo Repository) Count(userID string) (int64, error) {
+	var count int64
+	err := repo.DB.Model(&model.ShoppingList{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (repo Repository) GetByID(id string) (model.ShoppingList, error) {
+	var list model.ShoppingList
+	err := repo.DB.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	}).First(&list, "id = ?", id).Error
+	return list, err
+}
+
+// Create saves a list together with its items.
+func (repo Repository) Create(list *model.ShoppingList) error {
+	return repo.DB.Create(list).Error
+}
+
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Delete(&model.ShoppingList{}, id).Error
+}
+
+func (repo Repository) GetItem(listID uint, itemID string) (model.ShoppingListItem, error) {
+	var item model.ShoppingListItem
+	err := repo.DB.First(&item, "id = ? AND shopping_list_id = ?", itemID, listID).Error
+	return item, err
+}
+
+// UpdateItem writes whether the item is checked, the only thing a user
+// changes on an item.
+func (repo Repository) UpdateItem(item *model.ShoppingListItem) error {
+	return repo.DB.Model(item).Update("checked", item.Checked).Error
+}
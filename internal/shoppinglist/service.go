@@ -0,0 +1,199 @@
+package shoppinglist
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/mealplan"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.PageQuery, claims model.Claims) (model.ShoppingLists, int64, pagination.Cursors, error)
+	GetByID(id string, claims model.Claims) (model.ShoppingList, error)
+	Create(request dto.ShoppingListRequest, claims model.Claims) (model.ShoppingList, error)
+	Delete(id string, claims model.Claims) error
+	UpdateItem(id string, itemID string, request dto.ShoppingListItemRequest, claims model.Claims) (model.ShoppingListItem, error)
+}
+
+type Service struct {
+	Repository IRepository
+	Recipes    foodrecipe.IRepository
+	MealPlans  mealplan.IService
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+		Recipes:    foodrecipe.NewRepository(db),
+		MealPlans:  mealplan.NewService(db),
+	}
+}
+
+func (service Service) Get(query model.PageQuery, claims model.Claims) (model.ShoppingLists, int64, pagination.Cursors, error) {
+	total, err := service.Repository.Count(claims.ID)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "count shopping lists")
+	}
+
+	lists, cursors, err := service.Repository.Get(claims.ID, query)
+	if err != nil {
+		return nil, 0, pagination.Cursors{}, errors.Wrap(err, "get shopping lists")
+	}
+
+	return lists, total, cursors, nil
+}
+
+// GetByID returns a list of the caller. Lists are private, so the list of
+// someone else is reported as missing.
+func (service Service) GetByID(id string, claims model.Claims) (model.ShoppingList, error) {
+	list, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "find shopping list")
+	}
+
+	if list.UserID != claims.ID {
+		return model.ShoppingList{}, errors.Wrap(gorm.ErrRecordNotFound, "find shopping list")
+	}
+
+	return list, nil
+}
+
+// recipeIngredients returns the ingredients of the requested recipes, each
+// scaled to the servings asked for. A recipe that is deleted or that the
+// user may not open is an error, since it was asked for by ID.
+func (service Service) recipeIngredients(requests []dto.ShoppingListRecipeRequest, userID string) (model.RecipeIngredients, error) {
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.RecipeID)
+	}
+
+	recipes, err := service.Recipes.GetByIDs(ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "get recipes")
+	}
+
+	byID := make(map[uint]model.FoodRecipe, len(recipes))
+	for _, recipe := range recipes {
+		byID[recipe.ID] = recipe
+	}
+
+	var ingredients model.RecipeIngredients
+	for _, request := range requests {
+		recipe, ok := byID[request.RecipeID]
+		if !ok || !recipe.VisibleTo(userID) {
+			return nil, errors.Wrapf(gorm.ErrRecordNotFound, "find recipe %d", request.RecipeID)
+		}
+
+		ingredients = append(ingredients, foodrecipe.Scale(recipe, request.Servings).Ingredients...)
+	}
+
+	return ingredients, nil
+}
+
+// mealPlanIngredients returns the ingredients of the meals planned from one
+// date to another, scaled to the servings they were planned for.
+func (service Service) mealPlanIngredients(from string, to string, claims model.Claims) (model.RecipeIngredients, error) {
+	if from == "" {
+		return nil, nil
+	}
+
+	fromDate, err := mealplan.ParseDate(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toDate, err := mealplan.ParseDate(to)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := service.MealPlans.Get(model.MealPlanQuery{From: &fromDate, To: &toDate}, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	var ingredients model.RecipeIngredients
+	for _, entry := range plan.Entries {
+		ingredients = append(ingredients, entry.FoodRecipe.Ingredients...)
+	}
+
+	return ingredients, nil
+}
+
+// Create generates a list out of recipes, a meal plan or both, merging the
+// ingredients they share. Only structured ingredients can be merged, so
+// recipes written as free text alone add nothing.
+func (service Service) Create(request dto.ShoppingListRequest, claims model.Claims) (model.ShoppingList, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "request invalid")
+	}
+
+	ingredients, err := service.recipeIngredients(request.Recipes, claims.ID)
+	if err != nil {
+		return model.ShoppingList{}, err
+	}
+
+	planned, err := service.mealPlanIngredients(request.From, request.To, claims)
+	if err != nil {
+		return model.ShoppingList{}, err
+	}
+
+	name := request.Name
+	if name == "" && request.From != "" {
+		name = "Meal plan " + request.From + " to " + request.To
+	}
+	if name == "" {
+		name = "Shopping list"
+	}
+
+	list := model.ShoppingList{
+		UserID: claims.ID,
+		Name:   name,
+		Items:  Merge(append(ingredients, planned...)),
+	}
+
+	if err := service.Repository.Create(&list); err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "create shopping list")
+	}
+
+	return list, nil
+}
+
+func (service Service) Delete(id string, claims model.Claims) error {
+	list, err := service.GetByID(id, claims)
+	if err != nil {
+		return err
+	}
+
+	return service.Repository.Delete(list.ID)
+}
+
+// UpdateItem checks an item off, or back on.
+func (service Service) UpdateItem(id string, itemID string, request dto.ShoppingListItemRequest, claims model.Claims) (model.ShoppingListItem, error) {
+	list, err := service.GetByID(id, claims)
+	if err != nil {
+		return model.ShoppingListItem{}, err
+	}
+
+	item, err := service.Repository.GetItem(list.ID, itemID)
+	if err != nil {
+		return model.ShoppingListItem{}, errors.Wrap(err, "find shopping list item")
+	}
+
+	item.Checked = request.Checked
+
+	if err := service.Repository.UpdateItem(&item); err != nil {
+		return model.ShoppingListItem{}, errors.Wrap(err, "update shopping list item")
+	}
+
+	return item, nil
+}
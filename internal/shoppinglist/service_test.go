@@ -0,0 +1,91 @@
+package shoppinglist_test
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/shoppinglist"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	service   shoppinglist.IService
+	repo      *MockIRepository
+	recipes   *MockRecipeRepository
+	mealPlans *MockMealPlanService
+}
+
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.recipes = new(MockRecipeRepository)
+	suite.mealPlans = new(MockMealPlanService)
+	suite.service = &shoppinglist.Service{
+		Repository: suite.repo,
+		Recipes:    suite.recipes,
+		MealPlans:  suite.mealPlans,
+	}
+
+	suite.recipes.On("GetByIDs", mock.Anything).Return(model.FoodRecipes{
+		{Model: gorm.Model{ID: 1}, Servings: 2, Status: model.RecipeStatusPublished, Ingredients: model.RecipeIngredients{
+			{Name: "rice", Quantity: quantity(200), Unit: "g"},
+			{Name: "egg", Quantity: quantity(2)},
+		}},
+		{Model: gorm.Model{ID: 2}, Servings: 1, Status: model.RecipeStatusPrivate, UserID: "someone"},
+	}, nil)
+	suite.mealPlans.On("Get", mock.Anything, mock.Anything).Return(model.MealPlan{Entries: model.MealPlanEntries{
+		{FoodRecipe: model.FoodRecipe{Ingredients: model.RecipeIngredients{{Name: "Rice", Quantity: quantity(0.1), Unit: "kg"}}}},
+	}}, nil)
+	suite.repo.On("Create", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceCreateTestSuite) TestMergeRecipesScaledToServings() {
+	list, err := suite.service.Create(dto.ShoppingListRequest{
+		Recipes: []dto.ShoppingListRecipeRequest{{RecipeID: 1, Servings: 4}},
+	}, model.Claims{ID: "owner"})
+	suite.NoError(err)
+
+	suite.Equal("Shopping list", list.Name)
+	suite.Equal(model.ShoppingListItems{
+		{Name: "egg", Quantity: quantity(4), Aisle: "dairy-eggs", Position: 1},
+		{Name: "rice", Quantity: quantity(400), Unit: "g", Aisle: "pantry", Position: 2},
+	}, list.Items)
+	suite.repo.AssertCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestMergeMealPlanWithRecipes() {
+	list, err := suite.service.Create(dto.ShoppingListRequest{
+		Recipes: []dto.ShoppingListRecipeRequest{{RecipeID: 1}},
+		From:    "2026-10-19",
+		To:      "2026-10-25",
+	}, model.Claims{ID: "owner"})
+	suite.NoError(err)
+
+	suite.Equal("Meal plan 2026-10-19 to 2026-10-25", list.Name)
+	suite.Equal(quantity(300), list.Items[1].Quantity)
+}
+
+func (suite *ServiceCreateTestSuite) TestNotFoundWhenRecipeCannotBeOpened() {
+	_, err := suite.service.Create(dto.ShoppingListRequest{
+		Recipes: []dto.ShoppingListRecipeRequest{{RecipeID: 2}},
+	}, model.Claims{ID: "owner"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNothingToBuyFrom() {
+	_, err := suite.service.Create(dto.ShoppingListRequest{Name: "Empty"}, model.Claims{ID: "owner"})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
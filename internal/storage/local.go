@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Local keeps files in a directory, for development and single servers. The
+// API serves the directory itself, see cmd/server.
+type Local struct {
+	Dir       string
+	PublicURL string
+}
+
+func NewLocal(dir string, publicURL string) Store {
+	return &Local{
+		Dir:       dir,
+		PublicURL: strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+func (store Local) path(key string) string {
+	return filepath.Join(store.Dir, filepath.FromSlash(key))
+}
+
+// Put writes the file through a temporary one, so a reader never sees half
+// of it.
+func (store Local) Put(key string, data []byte, contentType string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	path := store.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrap(err, "create directory")
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errors.Wrap(err, "create file")
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return errors.Wrap(err, "write file")
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrap(err, "write file")
+	}
+	if err := os.Chmod(file.Name(), 0o644); err != nil {
+		return errors.Wrap(err, "write file")
+	}
+
+	return errors.Wrap(os.Rename(file.Name(), path), "write file")
+}
+
+// Delete removes the file. A file already gone is not an error.
+func (store Local) Delete(key string) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	if err := os.Remove(store.path(key)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "delete file")
+	}
+	return nil
+}
+
+func (store Local) URL(key string) string {
+	return store.PublicURL + "/" + key
+}
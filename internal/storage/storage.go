@@ -0,0 +1,60 @@
+// Package storage keeps uploaded files behind the Store interface, in a
+// local directory or an S3-compatible bucket. Keys are slash-separated paths
+// such as "images/<id>/small.jpg", and every stored file has a public URL.
+package storage
+
+import (
+	"strings"
+
+	"github.com/klins/devpool/go-day6/wongnok/config"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnknownDriver = errors.New("unknown storage driver")
+	ErrInvalidKey    = errors.New("invalid key")
+)
+
+type Store interface {
+	Put(key string, data []byte, contentType string) error
+	Delete(key string) error
+	URL(key string) string
+}
+
+// New opens the store the configuration picks.
+func New(conf config.Storage) (Store, error) {
+	switch conf.Driver {
+	case "local":
+		publicURL := conf.PublicURL
+		if publicURL == "" {
+			publicURL = "http://localhost:8080/uploads"
+		}
+		return NewLocal(conf.Dir, publicURL), nil
+	case "s3":
+		if conf.S3Endpoint == "" || conf.S3Bucket == "" {
+			return nil, errors.New("S3_ENDPOINT and S3_BUCKET are required")
+		}
+		return NewS3(S3Config{
+			Endpoint:        conf.S3Endpoint,
+			Region:          conf.S3Region,
+			Bucket:          conf.S3Bucket,
+			AccessKeyID:     conf.S3AccessKeyID,
+			SecretAccessKey: conf.S3SecretAccessKey,
+			PublicURL:       conf.PublicURL,
+		}), nil
+	}
+	return nil, errors.Wrap(ErrUnknownDriver, conf.Driver)
+}
+
+// checkKey refuses keys that are empty, absolute or step out of the store.
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return errors.Wrap(ErrInvalidKey, key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return errors.Wrap(ErrInvalidKey, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package tag
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Get(query model.TagQuery) (model.Tags, error)
+	GetByID(id string) (model.Tag, error)
+	Create(tag *model.Tag) error
+	Update(tag *model.Tag) error
+	Delete(id string) error
+	GetGroups() (model.TagGroups, error)
+	GetGroupByID(id string) (model.TagGroup, error)
+	CreateGroup(group *model.TagGroup) error
+	UpdateGroup(group *model.TagGroup) error
+	DeleteGroup(id string) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// Get lists tags with the number of published recipes using each of them,
+// the ones anybody can find by the tag.
+func (repo Repository) Get(query model.TagQuery) (model.Tags, error) {
+	var tags = make(model.Tags, 0)
+
+	db := repo.DB.Model(&model.Tag{}).
+		Select("tags.*, COUNT(food_recipes.id) AS recipe_count").
+		Joins("LEFT JOIN recipe_tags ON recipe_tags.tag_id = tags.id").
+		Joins(
+			"LEFT JOIN food_recipes ON food_recipes.id = recipe_tags.food_recipe_id AND food_recipes.deleted_at IS NULL AND food_recipes.status = ?",
+			model.RecipeStatusPublished,
+		).
+		Preload("TagGroup").
+		Group("tags.id")
+
+	if query.Group != "" {
+		db = db.Where("tags.tag_group_id IN (?)", repo.DB.Model(&model.TagGroup{}).Select("id").Where("slug = ?", query.Group))
+	}
+
+	if err := db.Order("tags.tag_group_id asc, tags.name asc").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (repo Repository) GetByID(id string) (model.Tag, error) {
+	var tag model.Tag
+	err := repo.DB.Preload("TagGroup").First(&tag, "id = ?", id).Error
+	return tag, err
+}
+
+func (repo Repository) Create(tag *model.Tag) error {
+	if err := repo.DB.Omit("TagGroup").Create(tag).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("TagGroup").First(tag, tag.ID).Error
+}
+
+func (repo Repository) Update(tag *model.Tag) error {
+	if err := repo.DB.Model(tag).Omit("TagGroup").Updates(tag).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("TagGroup").First(tag, tag.ID).Error
+}
+
+func (repo Repository) Delete(id string) error {
+	return repo.DB.Delete(&model.Tag{}, id).Error
+}
+
+func (repo Repository) GetGroups() (model.TagGroups, error) {
+	var groups = make(model.TagGroups, 0)
+
+	err := repo.DB.Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("name asc")
+	}).Order("name asc").Find(&groups).Error
+
+	return groups, err
+}
+
+func (repo Repository) GetGroupByID(id string) (model.TagGroup, error) {
+	var group model.TagGroup
+	err := repo.DB.First(&group, "id = ?", id).Error
+	return group, err
+}
+
+func (repo Repository) CreateGroup(group *model.TagGroup) error {
+	return repo.DB.Omit("Tags").Create(group).Error
+}
+
+func (repo Repository) UpdateGroup(group *model.TagGroup) error {
+	return repo.DB.Model(group).Omit("Tags").Updates(group).Error
+}
+
+// DeleteGroup removes the group together with its tags.
+func (repo Repository) DeleteGroup(id string) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_group_id = ?", id).Delete(&model.Tag{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&model.TagGroup{}, id).Error
+	})
+}
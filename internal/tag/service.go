@@ -0,0 +1,162 @@
+package tag
+
+import (
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.TagQuery) (model.Tags, error)
+	Create(request dto.TagRequest) (model.Tag, error)
+	Update(request dto.TagRequest, id string) (model.Tag, error)
+	Delete(id string) error
+	GetGroups() (model.TagGroups, error)
+	CreateGroup(request dto.TagGroupRequest) (model.TagGroup, error)
+	UpdateGroup(request dto.TagGroupRequest, id string) (model.TagGroup, error)
+	DeleteGroup(id string) error
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) Get(query model.TagQuery) (model.Tags, error) {
+	tags, err := service.Repository.Get(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "get tags")
+	}
+
+	return tags, nil
+}
+
+func (service Service) Create(request dto.TagRequest) (model.Tag, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Tag{}, errors.Wrap(err, "request invalid")
+	}
+
+	if _, err := service.Repository.GetGroupByID(toID(request.TagGroupID)); err != nil {
+		return model.Tag{}, errors.Wrap(err, "find tag group")
+	}
+
+	var tag model.Tag
+	tag = tag.FromRequest(request)
+
+	if err := service.Repository.Create(&tag); err != nil {
+		return model.Tag{}, errors.Wrap(nameTaken(err), "create tag")
+	}
+
+	return tag, nil
+}
+
+func (service Service) Update(request dto.TagRequest, id string) (model.Tag, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Tag{}, errors.Wrap(err, "request invalid")
+	}
+
+	tag, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Tag{}, errors.Wrap(err, "find tag")
+	}
+
+	if _, err := service.Repository.GetGroupByID(toID(request.TagGroupID)); err != nil {
+		return model.Tag{}, errors.Wrap(err, "find tag group")
+	}
+
+	tag = tag.FromRequest(request)
+
+	if err := service.Repository.Update(&tag); err != nil {
+		return model.Tag{}, errors.Wrap(nameTaken(err), "update tag")
+	}
+
+	return tag, nil
+}
+
+func (service Service) Delete(id string) error {
+	if _, err := service.Repository.GetByID(id); err != nil {
+		return errors.Wrap(err, "find tag")
+	}
+
+	return service.Repository.Delete(id)
+}
+
+func (service Service) GetGroups() (model.TagGroups, error) {
+	groups, err := service.Repository.GetGroups()
+	if err != nil {
+		return nil, errors.Wrap(err, "get tag groups")
+	}
+
+	return groups, nil
+}
+
+func (service Service) CreateGroup(request dto.TagGroupRequest) (model.TagGroup, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.TagGroup{}, errors.Wrap(err, "request invalid")
+	}
+
+	var group model.TagGroup
+	group = group.FromRequest(request)
+
+	if err := service.Repository.CreateGroup(&group); err != nil {
+		return model.TagGroup{}, errors.Wrap(nameTaken(err), "create tag group")
+	}
+
+	return group, nil
+}
+
+func (service Service) UpdateGroup(request dto.TagGroupRequest, id string) (model.TagGroup, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.TagGroup{}, errors.Wrap(err, "request invalid")
+	}
+
+	group, err := service.Repository.GetGroupByID(id)
+	if err != nil {
+		return model.TagGroup{}, errors.Wrap(err, "find tag group")
+	}
+
+	group = group.FromRequest(request)
+
+	if err := service.Repository.UpdateGroup(&group); err != nil {
+		return model.TagGroup{}, errors.Wrap(nameTaken(err), "update tag group")
+	}
+
+	return group, nil
+}
+
+func (service Service) DeleteGroup(id string) error {
+	if _, err := service.Repository.GetGroupByID(id); err != nil {
+		return errors.Wrap(err, "find tag group")
+	}
+
+	return service.Repository.DeleteGroup(id)
+}
+
+// nameTaken reports a unique violation, another live tag or group of the
+// same name or slug, as ErrorNameTaken.
+func nameTaken(err error) error {
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) && pgError.Code == "23505" {
+		return global.ErrorNameTaken
+	}
+	return err
+}
+
+func toID(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
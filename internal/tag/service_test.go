@@ -0,0 +1,101 @@
+package tag_test
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/tag"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	service tag.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &tag.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetGroupByID", "1").Return(model.TagGroup{Model: gorm.Model{ID: 1}, Name: "Region", Slug: "region"}, nil)
+	suite.repo.On("GetGroupByID", mock.AnythingOfType("string")).Return(model.TagGroup{}, gorm.ErrRecordNotFound)
+	suite.repo.On("Create", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceCreateTestSuite) TestReturnTagCreated() {
+	created, err := suite.service.Create(dto.TagRequest{TagGroupID: 1, Name: " อีสาน "})
+	suite.NoError(err)
+
+	suite.Equal(model.Tag{TagGroupID: 1, Name: "อีสาน"}, created)
+	suite.repo.AssertCalled(suite.T(), "Create", &model.Tag{TagGroupID: 1, Name: "อีสาน"})
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRequestInvalid() {
+	_, err := suite.service.Create(dto.TagRequest{TagGroupID: 1})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenGroupNotFound() {
+	_, err := suite.service.Create(dto.TagRequest{TagGroupID: 2, Name: "ภาคใต้"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNameTaken() {
+	suite.repo.ExpectedCalls = nil
+	suite.repo.On("GetGroupByID", "1").Return(model.TagGroup{Model: gorm.Model{ID: 1}}, nil)
+	suite.repo.On("Create", mock.Anything).Return(&pgconn.PgError{Code: "23505"})
+
+	_, err := suite.service.Create(dto.TagRequest{TagGroupID: 1, Name: "อีสาน"})
+	suite.ErrorIs(err, global.ErrorNameTaken)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+type ServiceCreateGroupTestSuite struct {
+	suite.Suite
+
+	service tag.IService
+	repo    *MockIRepository
+}
+
+func (suite *ServiceCreateGroupTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &tag.Service{
+		Repository: suite.repo,
+	}
+}
+
+func (suite *ServiceCreateGroupTestSuite) TestErrorWhenNameTaken() {
+	suite.repo.On("CreateGroup", mock.Anything).Return(&pgconn.PgError{Code: "23505"})
+
+	_, err := suite.service.CreateGroup(dto.TagGroupRequest{Name: "Region", Slug: "region"})
+	suite.ErrorIs(err, global.ErrorNameTaken)
+}
+
+func (suite *ServiceCreateGroupTestSuite) TestKeepOtherErrors() {
+	suite.repo.On("CreateGroup", mock.Anything).Return(&pgconn.PgError{Code: "23503"})
+
+	_, err := suite.service.CreateGroup(dto.TagGroupRequest{Name: "Region", Slug: "region"})
+	suite.Error(err)
+	suite.NotErrorIs(err, global.ErrorNameTaken)
+}
+
+func TestServiceCreateGroup(t *testing.T) {
+	suite.Run(t, new(ServiceCreateGroupTestSuite))
+}
@@ -0,0 +1,78 @@
+package units
+
+import (
+	"github.com/klins/devpool/go-day6/wongnok/internal/search"
+)
+
+// densities holds grams per millilitre for ingredients commonly measured by
+// volume. Keys are matched against the words of the ingredient name, the key
+// of most words first, so "rice flour" wins over "flour".
+var densities = map[string]float64{
+	"water":        1.0,
+	"น้ำ":          1.0,
+	"milk":         1.03,
+	"นม":           1.03,
+	"coconut milk": 0.97,
+	"กะทิ":         0.97,
+	"flour":        0.53,
+	"แป้ง":         0.53,
+	"rice flour":   0.62,
+	"แป้งข้าวเจ้า": 0.62,
+	"sugar":        0.85,
+	"น้ำตาล":       0.85,
+	"brown sugar":  0.93,
+	"palm sugar":   0.95,
+	"น้ำตาลปี๊บ":   0.95,
+	"น้ำตาลมะพร้าว": 0.95,
+	"salt":         1.2,
+	"เกลือ":        1.2,
+	"rice":         0.85,
+	"ข้าวสาร":      0.85,
+	"butter":       0.96,
+	"เนย":          0.96,
+	"oil":          0.92,
+	"น้ำมัน":       0.92,
+	"honey":        1.42,
+	"น้ำผึ้ง":      1.42,
+	"fish sauce":   1.2,
+	"น้ำปลา":       1.2,
+	"soy sauce":    1.15,
+	"ซีอิ๊ว":       1.15,
+	"oyster sauce": 1.25,
+	"ซอสหอยนางรม":  1.25,
+	"lime juice":   1.03,
+	"น้ำมะนาว":     1.03,
+	"tamarind":     1.1,
+	"มะขามเปียก":   1.1,
+	"cocoa":        0.42,
+	"โกโก้":        0.42,
+}
+
+// densityWords holds the keys of densities split into words.
+var densityWords = func() map[string][]string {
+	words := make(map[string][]string, len(densities))
+	for key := range densities {
+		words[key] = search.Tokenize(key)
+	}
+	return words
+}()
+
+// Density returns grams per millilitre for the ingredient, if known. The key
+// has to name what the ingredient is: the last words of an English name, as
+// in "jasmine rice" but not "rice vinegar", and the first of a Thai one, as
+// in "นมสด" but not "ขนมปัง".
+func Density(ingredient string) (float64, bool) {
+	words := search.Tokenize(ingredient)
+
+	var match string
+	for key, keyWords := range densityWords {
+		if search.IsHead(words, keyWords) && (match == "" || len(keyWords) > len(densityWords[match])) {
+			match = key
+		}
+	}
+
+	if match == "" {
+		return 0, false
+	}
+	return densities[match], true
+}
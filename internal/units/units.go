@@ -0,0 +1,176 @@
+// Package units converts ingredient amounts between metric, US customary and
+// Thai kitchen measures.
+package units
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnknownUnit       = errors.New("unknown unit")
+	ErrIncompatibleUnits = errors.New("incompatible units")
+	ErrUnknownDensity    = errors.New("unknown density")
+)
+
+type Dimension int
+
+const (
+	Mass Dimension = iota + 1
+	Volume
+)
+
+type System string
+
+const (
+	Metric System = "metric"
+	US     System = "us"
+	Thai   System = "thai"
+)
+
+type Unit struct {
+	// Name is the symbol written back to recipes, e.g. "g" or "tbsp"
+	Name      string
+	Dimension Dimension
+	System    System
+	// Factor is how many grams (Mass) or millilitres (Volume) one unit holds
+	Factor float64
+	// MinAmount is the smallest amount worth writing in this unit before a
+	// smaller unit reads better, e.g. ¼ cup but not ⅛ cup. Zero keeps the
+	// unit out of ToSystem unless it is the smallest one left.
+	MinAmount float64
+	Aliases   []string
+}
+
+var table = []Unit{
+	{Name: "g", Dimension: Mass, System: Metric, Factor: 1, MinAmount: 1, Aliases: []string{"gram", "grams", "gr", "กรัม", "ก."}},
+	{Name: "kg", Dimension: Mass, System: Metric, Factor: 1000, MinAmount: 1, Aliases: []string{"kilogram", "kilograms", "kilo", "kgs", "กิโลกรัม", "กิโล", "กก."}},
+	{Name: "ml", Dimension: Volume, System: Metric, Factor: 1, MinAmount: 1, Aliases: []string{"milliliter", "milliliters", "millilitre", "millilitres", "cc", "มิลลิลิตร", "มล.", "ซีซี"}},
+	{Name: "l", Dimension: Volume, System: Metric, Factor: 1000, MinAmount: 1, Aliases: []string{"liter", "liters", "litre", "litres", "ลิตร"}},
+
+	{Name: "oz", Dimension: Mass, System: US, Factor: 28.3495, MinAmount: 1, Aliases: []string{"ounce", "ounces"}},
+	{Name: "lb", Dimension: Mass, System: US, Factor: 453.592, MinAmount: 1, Aliases: []string{"pound", "pounds", "lbs"}},
+	{Name: "tsp", Dimension: Volume, System: US, Factor: 4.92892, MinAmount: 0, Aliases: []string{"teaspoon", "teaspoons", "tsps"}},
+	{Name: "tbsp", Dimension: Volume, System: US, Factor: 14.7868, MinAmount: 1, Aliases: []string{"tablespoon", "tablespoons", "tbs", "tbsps"}},
+	{Name: "fl oz", Dimension: Volume, System: US, Factor: 29.5735, MinAmount: 0, Aliases: []string{"floz", "fl. oz", "fluid ounce", "fluid ounces"}},
+	{Name: "cup", Dimension: Volume, System: US, Factor: 236.588, MinAmount: 0.25, Aliases: []string{"cups", "c"}},
+
+	// Thai measuring spoons and cups follow metric sizes
+	{Name: "ช้อนชา", Dimension: Volume, System: Thai, Factor: 5, Aliases: []string{"ชช.", "ช.ช."}},
+	{Name: "ช้อนโต๊ะ", Dimension: Volume, System: Thai, Factor: 15, Aliases: []string{"ชต.", "ช.ต."}},
+	{Name: "ถ้วย", Dimension: Volume, System: Thai, Factor: 240, Aliases: []string{"ถ้วยตวง"}},
+	{Name: "ขีด", Dimension: Mass, System: Thai, Factor: 100},
+}
+
+var lookup = func() map[string]Unit {
+	results := make(map[string]Unit)
+	for _, unit := range table {
+		results[normalize(unit.Name)] = unit
+		for _, alias := range unit.Aliases {
+			results[normalize(alias)] = unit
+		}
+	}
+	return results
+}()
+
+func normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Lookup finds a unit by its symbol, name or Thai name.
+func Lookup(name string) (Unit, bool) {
+	unit, ok := lookup[normalize(name)]
+	return unit, ok
+}
+
+// Convert converts quantity from one unit to another. Converting between
+// mass and volume uses the density of the ingredient.
+func Convert(quantity float64, from, to string, ingredient string) (float64, error) {
+	fromUnit, ok := Lookup(from)
+	if !ok {
+		return 0, errors.Wrap(ErrUnknownUnit, from)
+	}
+
+	toUnit, ok := Lookup(to)
+	if !ok {
+		return 0, errors.Wrap(ErrUnknownUnit, to)
+	}
+
+	base, err := toBase(quantity*fromUnit.Factor, fromUnit.Dimension, toUnit.Dimension, ingredient)
+	if err != nil {
+		return 0, err
+	}
+
+	return base / toUnit.Factor, nil
+}
+
+// ToGrams converts an amount to grams, going through the ingredient density
+// for volume units.
+func ToGrams(quantity float64, unit string, ingredient string) (float64, error) {
+	return Convert(quantity, unit, "g", ingredient)
+}
+
+// ToSystem rewrites an amount in the most readable unit of the target
+// system, keeping its dimension. Amounts in units it does not know, such as
+// "clove" or "ฟอง", are returned unchanged with ok set to false.
+func ToSystem(quantity float64, unit string, system System) (float64, string, bool) {
+	from, found := Lookup(unit)
+	if !found {
+		return quantity, unit, false
+	}
+
+	candidates := unitsOf(system, from.Dimension)
+	if len(candidates) == 0 {
+		return quantity, unit, false
+	}
+
+	base := quantity * from.Factor
+
+	// Largest unit first, fall back to the smallest one
+	best := candidates[len(candidates)-1]
+	for _, candidate := range candidates {
+		if candidate.MinAmount > 0 && base/candidate.Factor >= candidate.MinAmount {
+			best = candidate
+			break
+		}
+	}
+
+	return base / best.Factor, best.Name, true
+}
+
+func unitsOf(system System, dimension Dimension) []Unit {
+	var results []Unit
+	for _, unit := range table {
+		if unit.System == system && unit.Dimension == dimension {
+			results = append(results, unit)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Factor > results[j].Factor
+	})
+
+	return results
+}
+
+func toBase(base float64, from, to Dimension, ingredient string) (float64, error) {
+	if from == to {
+		return base, nil
+	}
+
+	density, ok := Density(ingredient)
+	if !ok {
+		return 0, errors.Wrap(ErrUnknownDensity, ingredient)
+	}
+
+	switch {
+	case from == Volume && to == Mass:
+		return base * density, nil
+	case from == Mass && to == Volume:
+		return base / density, nil
+	default:
+		return 0, ErrIncompatibleUnits
+	}
+}
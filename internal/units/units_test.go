@@ -0,0 +1,118 @@
+package units_test
+
+import (
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/units"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	t.Run("ShouldFindThaiAndAliasNames", func(t *testing.T) {
+		for name, expected := range map[string]string{
+			"Tablespoons": "tbsp",
+			"ช้อนโต๊ะ":    "ช้อนโต๊ะ",
+			"ชต.":         "ช้อนโต๊ะ",
+			"กิโล":        "kg",
+			" ML ":        "ml",
+		} {
+			unit, ok := units.Lookup(name)
+			assert.True(t, ok, name)
+			assert.Equal(t, expected, unit.Name, name)
+		}
+	})
+
+	t.Run("ShouldNotFindCountUnits", func(t *testing.T) {
+		_, ok := units.Lookup("ฟอง")
+		assert.False(t, ok)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	t.Run("ShouldConvertWithinDimension", func(t *testing.T) {
+		quantity, err := units.Convert(2, "ช้อนโต๊ะ", "ml", "")
+		assert.NoError(t, err)
+		assert.Equal(t, 30.0, quantity)
+
+		quantity, err = units.Convert(1, "lb", "g", "")
+		assert.NoError(t, err)
+		assert.InDelta(t, 453.592, quantity, 0.001)
+	})
+
+	t.Run("ShouldUseIngredientDensityBetweenVolumeAndMass", func(t *testing.T) {
+		quantity, err := units.Convert(1, "ถ้วย", "g", "แป้งข้าวเจ้า")
+		assert.NoError(t, err)
+		assert.InDelta(t, 148.8, quantity, 0.001)
+
+		quantity, err = units.ToGrams(1, "tbsp", "Fish sauce")
+		assert.NoError(t, err)
+		assert.InDelta(t, 17.744, quantity, 0.001)
+	})
+
+	t.Run("ShouldErrorWhenDensityUnknown", func(t *testing.T) {
+		_, err := units.Convert(1, "cup", "g", "basil")
+		assert.ErrorIs(t, err, units.ErrUnknownDensity)
+	})
+
+	t.Run("ShouldErrorWhenUnitUnknown", func(t *testing.T) {
+		_, err := units.Convert(1, "clove", "g", "garlic")
+		assert.ErrorIs(t, err, units.ErrUnknownUnit)
+	})
+}
+
+func TestDensity(t *testing.T) {
+	cases := map[string]struct {
+		ingredient string
+		expected   float64
+		known      bool
+	}{
+		"ShouldMatchEnglishHead":          {ingredient: "Jasmine rice", expected: 0.85, known: true},
+		"ShouldPreferKeyOfMoreWords":      {ingredient: "rice flour", expected: 0.62, known: true},
+		"ShouldMatchThaiHead":             {ingredient: "นมสด", expected: 1.03, known: true},
+		"ShouldMatchThaiSauceOverWater":   {ingredient: "น้ำปลา", expected: 1.2, known: true},
+		"ShouldNotMatchModifier":          {ingredient: "rice vinegar"},
+		"ShouldNotMatchPartOfThaiWord":    {ingredient: "ขนมปัง"},
+		"ShouldNotMatchWaterInThaiSauces": {ingredient: "น้ำจิ้มไก่"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			density, ok := units.Density(tc.ingredient)
+			assert.Equal(t, tc.known, ok)
+			assert.Equal(t, tc.expected, density)
+		})
+	}
+}
+
+func TestToSystem(t *testing.T) {
+	cases := map[string]struct {
+		quantity         float64
+		unit             string
+		system           units.System
+		expectedQuantity float64
+		expectedUnit     string
+	}{
+		"ShouldPickCupForLargeVolume":      {quantity: 480, unit: "ml", system: units.US, expectedQuantity: 2.029, expectedUnit: "cup"},
+		"ShouldPickTablespoonForThaiSpoon": {quantity: 2, unit: "ช้อนโต๊ะ", system: units.US, expectedQuantity: 2.029, expectedUnit: "tbsp"},
+		"ShouldPickTeaspoonForTinyVolume":  {quantity: 2, unit: "ml", system: units.US, expectedQuantity: 0.406, expectedUnit: "tsp"},
+		"ShouldPickOunceForMass":           {quantity: 100, unit: "g", system: units.US, expectedQuantity: 3.527, expectedUnit: "oz"},
+		"ShouldPickMillilitreForCup":       {quantity: 1, unit: "cup", system: units.Metric, expectedQuantity: 236.588, expectedUnit: "ml"},
+		"ShouldPickKilogramForLargeMass":   {quantity: 3, unit: "lb", system: units.Metric, expectedQuantity: 1.361, expectedUnit: "kg"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			quantity, unit, ok := units.ToSystem(tc.quantity, tc.unit, tc.system)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expectedUnit, unit)
+			assert.InDelta(t, tc.expectedQuantity, quantity, 0.001)
+		})
+	}
+
+	t.Run("ShouldKeepUnknownUnit", func(t *testing.T) {
+		quantity, unit, ok := units.ToSystem(2, "ฟอง", units.US)
+		assert.False(t, ok)
+		assert.Equal(t, 2.0, quantity)
+		assert.Equal(t, "ฟอง", unit)
+	})
+}
@@ -0,0 +1,120 @@
+package upload
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
+	"github.com/klins/devpool/go-day6/wongnok/internal/imaging"
+	"github.com/klins/devpool/go-day6/wongnok/internal/storage"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MaxUploadSize is the largest image the upload endpoints read. Phone photos
+// are rarely over a few megabytes.
+const MaxUploadSize = 10 << 20
+
+type IHandler interface {
+	RecipeImage(ctx *gin.Context)
+	Avatar(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB, store storage.Store) IHandler {
+	return &Handler{
+		Service: NewService(db, store),
+	}
+}
+
+// readImage reads the "image" file of a multipart form, answering the
+// request itself when it cannot.
+func readImage(ctx *gin.Context) ([]byte, bool) {
+	header, err := helper.FormFile(ctx, "image", MaxUploadSize)
+	if errors.Is(err, global.ErrorTooLarge) {
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"message": "Image is too large"})
+		return nil, false
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return nil, false
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return nil, false
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxUploadSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return nil, false
+	}
+
+	return data, true
+}
+
+func (handler Handler) RecipeImage(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	data, ok := readImage(ctx)
+	if !ok {
+		return
+	}
+
+	recipe, err := handler.Service.RecipeImage(ctx.Param("id"), data, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, recipe.ToResponse())
+}
+
+func (handler Handler) Avatar(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	data, ok := readImage(ctx)
+	if !ok {
+		return
+	}
+
+	user, err := handler.Service.Avatar(ctx.Param("id"), data, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user.ToResponse())
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, imaging.ErrUnsupportedType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, imaging.ErrInvalidImage), errors.Is(err, imaging.ErrTooManyPixels),
+		errors.As(err, &validator.ValidationErrors{}):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrorForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,137 @@
+package upload
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/foodrecipe"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/imaging"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/storage"
+	"github.com/klins/devpool/go-day6/wongnok/internal/user"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	RecipeImage(id string, data []byte, claims model.Claims) (model.FoodRecipe, error)
+	Avatar(id string, data []byte, claims model.Claims) (model.User, error)
+}
+
+type Service struct {
+	Recipes foodrecipe.IService
+	Users   user.IService
+	Store   storage.Store
+	NewID   func() string
+}
+
+func NewService(db *gorm.DB, store storage.Store) IService {
+	return &Service{
+		Recipes: foodrecipe.NewService(db),
+		Users:   user.NewService(db),
+		Store:   store,
+		NewID:   newID,
+	}
+}
+
+// newID names an upload. IDs are random so no one can guess the images of
+// a draft, and never reused so the files can be cached for good.
+func newID() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// RecipeImage makes the upload the picture of a recipe of the caller. The
+// change goes through the recipe update, so it is kept as a revision. The
+// previous picture stays in the store: forks and revisions may still show it.
+func (service Service) RecipeImage(id string, data []byte, claims model.Claims) (model.FoodRecipe, error) {
+	recipe, err := service.Recipes.GetByID(id, claims)
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	if recipe.UserID != claims.ID {
+		return model.FoodRecipe{}, global.ErrorForbidden
+	}
+
+	imageURL, keys, err := service.store(data)
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	request := recipe.ToRequest()
+	request.ImageURL = &imageURL
+
+	recipe, err = service.Recipes.Update(request, id, claims)
+	if err != nil {
+		service.remove(keys)
+		return model.FoodRecipe{}, err
+	}
+
+	return recipe, nil
+}
+
+// Avatar makes the upload the picture of the caller.
+func (service Service) Avatar(id string, data []byte, claims model.Claims) (model.User, error) {
+	if id != claims.ID {
+		return model.User{}, global.ErrorForbidden
+	}
+
+	found, err := service.Users.GetByID(id)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	imageURL, keys, err := service.store(data)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	found, err = service.Users.Update(id, dto.UserRequest{
+		FirstName: found.FirstName,
+		LastName:  found.LastName,
+		ImageURL:  imageURL,
+	}, claims)
+	if err != nil {
+		service.remove(keys)
+		return model.User{}, err
+	}
+
+	return found, nil
+}
+
+// store writes the upload and its thumbnails, returning the URL of the
+// original and the keys of every file. Nothing is left behind on failure.
+func (service Service) store(data []byte) (string, []string, error) {
+	processed, err := imaging.Process(data)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "process image")
+	}
+
+	id := service.NewID()
+	keys := make([]string, 0, len(processed.Variants))
+	for _, variant := range processed.Variants {
+		key := model.ImageKey(id, variant.Name, processed.Extension)
+		if err := service.Store.Put(key, variant.Data, processed.ContentType); err != nil {
+			service.remove(keys)
+			return "", nil, errors.Wrap(err, "store image")
+		}
+		keys = append(keys, key)
+	}
+
+	return service.Store.URL(model.ImageKey(id, "original", processed.Extension)), keys, nil
+}
+
+// remove deletes files of a failed upload. The upload has failed already,
+// so errors are only logged.
+func (service Service) remove(keys []string) {
+	for _, key := range keys {
+		if err := service.Store.Delete(key); err != nil {
+			log.Printf("Error when removing %s: %v", key, err)
+		}
+	}
+}
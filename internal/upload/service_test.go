@@ -0,0 +1,163 @@
+package upload_test
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"testing"
+
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
+	"github.com/klins/devpool/go-day6/wongnok/internal/imaging"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/upload"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+const (
+	ownerID    = "owner"
+	strangerID = "stranger"
+	uploadID   = "0123456789abcdef0123456789abcdef"
+)
+
+func photo() []byte {
+	var buffer bytes.Buffer
+	jpeg.Encode(&buffer, image.NewGray(image.Rect(0, 0, 400, 300)), nil)
+	return buffer.Bytes()
+}
+
+type ServiceRecipeImageTestSuite struct {
+	suite.Suite
+
+	service upload.IService
+	recipes *MockRecipeService
+	store   *MockStore
+}
+
+func (suite *ServiceRecipeImageTestSuite) SetupTest() {
+	suite.recipes = new(MockRecipeService)
+	suite.store = new(MockStore)
+	suite.service = &upload.Service{
+		Recipes: suite.recipes,
+		Store:   suite.store,
+		NewID:   func() string { return uploadID },
+	}
+
+	suite.recipes.On("GetByID", "1", mock.Anything).Return(model.FoodRecipe{Model: gorm.Model{ID: 1}, Name: "Pad Kra Pao", UserID: ownerID, Servings: 2}, nil)
+	suite.recipes.On("GetByID", "2", mock.Anything).Return(model.FoodRecipe{}, errors.Wrap(gorm.ErrRecordNotFound, "get recipe"))
+	suite.store.On("Put", mock.Anything, mock.Anything, "image/jpeg").Return(nil)
+	suite.store.On("Delete", mock.Anything).Return(nil)
+	suite.store.On("URL", mock.Anything).Return(func(key string) string { return "https://cdn.example.com/" + key })
+}
+
+func (suite *ServiceRecipeImageTestSuite) TestStoreEverySizeAndUpdateRecipe() {
+	var request dto.FoodRecipeRequest
+	suite.recipes.On("Update", mock.Anything, "1", mock.Anything).Run(func(args mock.Arguments) {
+		request = args.Get(0).(dto.FoodRecipeRequest)
+	}).Return(model.FoodRecipe{Model: gorm.Model{ID: 1}}, nil)
+
+	_, err := suite.service.RecipeImage("1", photo(), model.Claims{ID: ownerID})
+	suite.NoError(err)
+
+	for _, size := range []string{"original", "large", "medium", "small"} {
+		suite.store.AssertCalled(suite.T(), "Put", "images/"+uploadID+"/"+size+".jpg", mock.Anything, "image/jpeg")
+	}
+	suite.Equal("https://cdn.example.com/images/"+uploadID+"/original.jpg", *request.ImageURL)
+	suite.Equal("Pad Kra Pao", request.Name)
+	suite.Equal(2, request.Servings)
+	suite.store.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *ServiceRecipeImageTestSuite) TestRecipeOfSomeoneElse() {
+	_, err := suite.service.RecipeImage("1", photo(), model.Claims{ID: strangerID})
+	suite.ErrorIs(err, global.ErrorForbidden)
+
+	suite.store.AssertNotCalled(suite.T(), "Put", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceRecipeImageTestSuite) TestRecipeNotFound() {
+	_, err := suite.service.RecipeImage("2", photo(), model.Claims{ID: ownerID})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ServiceRecipeImageTestSuite) TestNotAnImage() {
+	_, err := suite.service.RecipeImage("1", []byte("%PDF-1.7"), model.Claims{ID: ownerID})
+	suite.ErrorIs(err, imaging.ErrUnsupportedType)
+
+	suite.store.AssertNotCalled(suite.T(), "Put", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceRecipeImageTestSuite) TestRemoveFilesWhenUpdateFails() {
+	suite.recipes.On("Update", mock.Anything, "1", mock.Anything).Return(model.FoodRecipe{}, errors.New("update recipe"))
+
+	_, err := suite.service.RecipeImage("1", photo(), model.Claims{ID: ownerID})
+	suite.Error(err)
+
+	suite.store.AssertNumberOfCalls(suite.T(), "Delete", 4)
+}
+
+func (suite *ServiceRecipeImageTestSuite) TestRemoveFilesWhenStoreFails() {
+	suite.store.ExpectedCalls = nil
+	suite.store.On("Put", "images/"+uploadID+"/medium.jpg", mock.Anything, mock.Anything).Return(errors.New("disk full"))
+	suite.store.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	suite.store.On("Delete", mock.Anything).Return(nil)
+
+	_, err := suite.service.RecipeImage("1", photo(), model.Claims{ID: ownerID})
+	suite.ErrorContains(err, "disk full")
+
+	suite.store.AssertCalled(suite.T(), "Delete", "images/"+uploadID+"/original.jpg")
+	suite.store.AssertCalled(suite.T(), "Delete", "images/"+uploadID+"/large.jpg")
+	suite.store.AssertNumberOfCalls(suite.T(), "Delete", 2)
+	suite.recipes.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestServiceRecipeImage(t *testing.T) {
+	suite.Run(t, new(ServiceRecipeImageTestSuite))
+}
+
+type ServiceAvatarTestSuite struct {
+	suite.Suite
+
+	service upload.IService
+	users   *MockUserService
+	store   *MockStore
+}
+
+func (suite *ServiceAvatarTestSuite) SetupTest() {
+	suite.users = new(MockUserService)
+	suite.store = new(MockStore)
+	suite.service = &upload.Service{
+		Users: suite.users,
+		Store: suite.store,
+		NewID: func() string { return uploadID },
+	}
+
+	suite.users.On("GetByID", ownerID).Return(model.User{ID: ownerID, FirstName: "Somchai", LastName: "Jaidee"}, nil)
+	suite.store.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	suite.store.On("URL", mock.Anything).Return(func(key string) string { return "/uploads/" + key })
+}
+
+func (suite *ServiceAvatarTestSuite) TestKeepNameAndSetImage() {
+	suite.users.On("Update", ownerID, dto.UserRequest{
+		FirstName: "Somchai",
+		LastName:  "Jaidee",
+		ImageURL:  "/uploads/images/" + uploadID + "/original.jpg",
+	}, mock.Anything).Return(model.User{ID: ownerID}, nil)
+
+	_, err := suite.service.Avatar(ownerID, photo(), model.Claims{ID: ownerID})
+	suite.NoError(err)
+}
+
+func (suite *ServiceAvatarTestSuite) TestAvatarOfSomeoneElse() {
+	_, err := suite.service.Avatar(ownerID, photo(), model.Claims{ID: strangerID})
+	suite.ErrorIs(err, global.ErrorForbidden)
+
+	suite.users.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+}
+
+func TestServiceAvatar(t *testing.T) {
+	suite.Run(t, new(ServiceAvatarTestSuite))
+}
@@ -4,8 +4,11 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/klins/devpool/go-day6/wongnok/internal/global"
 	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
@@ -34,13 +37,27 @@ func (handler Handler) GetRecipes(ctx *gin.Context) {
 		return
 	}
 
-	recipes, err := handler.Service.GetRecipes(userID, claims)
+	var pageQuery model.PageQuery
+	if err := ctx.ShouldBindQuery(&pageQuery); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipes, total, cursors, err := handler.Service.GetRecipes(userID, pageQuery, claims)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, global.ErrorInvalidCursor) {
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, recipes.ToResponse(int64(len(recipes))))
+	response := recipes.ToResponse(total)
+	response.NextCursor, response.PrevCursor = cursors.Next, cursors.Prev
+
+	ctx.JSON(http.StatusOK, response)
 }
 
 func (handler Handler) GetByID(ctx *gin.Context) {
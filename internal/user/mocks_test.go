@@ -7,6 +7,8 @@ package user_test
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,6 +39,46 @@ func (_m *MockIHandler) EXPECT() *MockIHandler_Expecter {
 	return &MockIHandler_Expecter{mock: &_m.Mock}
 }
 
+// GetByID provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetByID(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIHandler_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetByID(ctx interface{}) *MockIHandler_GetByID_Call {
+	return &MockIHandler_GetByID_Call{Call: _e.mock.On("GetByID", ctx)}
+}
+
+func (_c *MockIHandler_GetByID_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetByID_Call) Return() *MockIHandler_GetByID_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetByID_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetByID_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetRecipes provides a mock function for the type MockIHandler
 func (_mock *MockIHandler) GetRecipes(ctx *gin.Context) {
 	_mock.Called(ctx)
@@ -77,6 +119,46 @@ func (_c *MockIHandler_GetRecipes_Call) RunAndReturn(run func(ctx *gin.Context))
 	return _c
 }
 
+// Update provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Update(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Update(ctx interface{}) *MockIHandler_Update_Call {
+	return &MockIHandler_Update_Call{Call: _e.mock.On("Update", ctx)}
+}
+
+func (_c *MockIHandler_Update_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) Return() *MockIHandler_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIRepository(t interface {
@@ -104,6 +186,72 @@ func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
 	return &MockIRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountRecipes provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) CountRecipes(userID string, onlyPublished bool) (int64, error) {
+	ret := _mock.Called(userID, onlyPublished)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountRecipes")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, bool) (int64, error)); ok {
+		return returnFunc(userID, onlyPublished)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, bool) int64); ok {
+		r0 = returnFunc(userID, onlyPublished)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = returnFunc(userID, onlyPublished)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_CountRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountRecipes'
+type MockIRepository_CountRecipes_Call struct {
+	*mock.Call
+}
+
+// CountRecipes is a helper method to define mock.On call
+//   - userID string
+//   - onlyPublished bool
+func (_e *MockIRepository_Expecter) CountRecipes(userID interface{}, onlyPublished interface{}) *MockIRepository_CountRecipes_Call {
+	return &MockIRepository_CountRecipes_Call{Call: _e.mock.On("CountRecipes", userID, onlyPublished)}
+}
+
+func (_c *MockIRepository_CountRecipes_Call) Run(run func(userID string, onlyPublished bool)) *MockIRepository_CountRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_CountRecipes_Call) Return(n int64, err error) *MockIRepository_CountRecipes_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_CountRecipes_Call) RunAndReturn(run func(userID string, onlyPublished bool) (int64, error)) *MockIRepository_CountRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type MockIRepository
 func (_mock *MockIRepository) GetByID(id string) (model.User, error) {
 	ret := _mock.Called(id)
@@ -164,6 +312,199 @@ func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id string) (model.
 	return _c
 }
 
+// GetMyFavorites provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetMyFavorites(userID string) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMyFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipes); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetMyFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMyFavorites'
+type MockIRepository_GetMyFavorites_Call struct {
+	*mock.Call
+}
+
+// GetMyFavorites is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) GetMyFavorites(userID interface{}) *MockIRepository_GetMyFavorites_Call {
+	return &MockIRepository_GetMyFavorites_Call{Call: _e.mock.On("GetMyFavorites", userID)}
+}
+
+func (_c *MockIRepository_GetMyFavorites_Call) Run(run func(userID string)) *MockIRepository_GetMyFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetMyFavorites_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetMyFavorites_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetMyFavorites_Call) RunAndReturn(run func(userID string) (model.FoodRecipes, error)) *MockIRepository_GetMyFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetRecipes(userID string, onlyPublished bool, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error) {
+	ret := _mock.Called(userID, onlyPublished, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 pagination.Cursors
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, bool, model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)); ok {
+		return returnFunc(userID, onlyPublished, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, bool, model.PageQuery) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, onlyPublished, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, bool, model.PageQuery) pagination.Cursors); ok {
+		r1 = returnFunc(userID, onlyPublished, query)
+	} else {
+		r1 = ret.Get(1).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, bool, model.PageQuery) error); ok {
+		r2 = returnFunc(userID, onlyPublished, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIRepository_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIRepository_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - onlyPublished bool
+//   - query model.PageQuery
+func (_e *MockIRepository_Expecter) GetRecipes(userID interface{}, onlyPublished interface{}, query interface{}) *MockIRepository_GetRecipes_Call {
+	return &MockIRepository_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, onlyPublished, query)}
+}
+
+func (_c *MockIRepository_GetRecipes_Call) Run(run func(userID string, onlyPublished bool, query model.PageQuery)) *MockIRepository_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		var arg2 model.PageQuery
+		if args[2] != nil {
+			arg2 = args[2].(model.PageQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, cursors pagination.Cursors, err error) *MockIRepository_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, cursors, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetRecipes_Call) RunAndReturn(run func(userID string, onlyPublished bool, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)) *MockIRepository_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(user *model.User) error {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIRepository_Expecter) Update(user interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", user)}
+}
+
+func (_c *MockIRepository_Update_Call) Run(run func(user *model.User)) *MockIRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) RunAndReturn(run func(user *model.User) error) *MockIRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Upsert provides a mock function for the type MockIRepository
 func (_mock *MockIRepository) Upsert(user *model.User) error {
 	ret := _mock.Called(user)
@@ -242,6 +583,286 @@ func (_m *MockIService) EXPECT() *MockIService_Expecter {
 	return &MockIService_Expecter{mock: &_m.Mock}
 }
 
+// GetByID provides a mock function for the type MockIService
+func (_mock *MockIService) GetByID(id string) (model.User, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.User, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.User); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockIService_Expecter) GetByID(id interface{}) *MockIService_GetByID_Call {
+	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIService_GetByID_Call) Run(run func(id string)) *MockIService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) Return(user model.User, err error) *MockIService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id string) (model.User, error)) *MockIService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMyFavorites provides a mock function for the type MockIService
+func (_mock *MockIService) GetMyFavorites(userID string) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMyFavorites")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipes); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetMyFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMyFavorites'
+type MockIService_GetMyFavorites_Call struct {
+	*mock.Call
+}
+
+// GetMyFavorites is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIService_Expecter) GetMyFavorites(userID interface{}) *MockIService_GetMyFavorites_Call {
+	return &MockIService_GetMyFavorites_Call{Call: _e.mock.On("GetMyFavorites", userID)}
+}
+
+func (_c *MockIService_GetMyFavorites_Call) Run(run func(userID string)) *MockIService_GetMyFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetMyFavorites_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIService_GetMyFavorites_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIService_GetMyFavorites_Call) RunAndReturn(run func(userID string) (model.FoodRecipes, error)) *MockIService_GetMyFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIService
+func (_mock *MockIService) GetRecipes(userID string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 pagination.Cursors
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.PageQuery, model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.PageQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.PageQuery, model.Claims) int64); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, model.PageQuery, model.Claims) pagination.Cursors); ok {
+		r2 = returnFunc(userID, query, claims)
+	} else {
+		r2 = ret.Get(2).(pagination.Cursors)
+	}
+	if returnFunc, ok := ret.Get(3).(func(string, model.PageQuery, model.Claims) error); ok {
+		r3 = returnFunc(userID, query, claims)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockIService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIService_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - query model.PageQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetRecipes(userID interface{}, query interface{}, claims interface{}) *MockIService_GetRecipes_Call {
+	return &MockIService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, query, claims)}
+}
+
+func (_c *MockIService_GetRecipes_Call) Run(run func(userID string, query model.PageQuery, claims model.Claims)) *MockIService_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.PageQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PageQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, n int64, cursors pagination.Cursors, err error) *MockIService_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, n, cursors, err)
+	return _c
+}
+
+func (_c *MockIService_GetRecipes_Call) RunAndReturn(run func(userID string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)) *MockIService_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIService
+func (_mock *MockIService) Update(id string, request dto.UserRequest, claims model.Claims) (model.User, error) {
+	ret := _mock.Called(id, request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, dto.UserRequest, model.Claims) (model.User, error)); ok {
+		return returnFunc(id, request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, dto.UserRequest, model.Claims) model.User); ok {
+		r0 = returnFunc(id, request, claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, dto.UserRequest, model.Claims) error); ok {
+		r1 = returnFunc(id, request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - id string
+//   - request dto.UserRequest
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Update(id interface{}, request interface{}, claims interface{}) *MockIService_Update_Call {
+	return &MockIService_Update_Call{Call: _e.mock.On("Update", id, request, claims)}
+}
+
+func (_c *MockIService_Update_Call) Run(run func(id string, request dto.UserRequest, claims model.Claims)) *MockIService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 dto.UserRequest
+		if args[1] != nil {
+			arg1 = args[1].(dto.UserRequest)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Update_Call) Return(user model.User, err error) *MockIService_Update_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIService_Update_Call) RunAndReturn(run func(id string, request dto.UserRequest, claims model.Claims) (model.User, error)) *MockIService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertWithClaims provides a mock function for the type MockIService
 func (_mock *MockIService) UpsertWithClaims(claims model.Claims) (model.User, error) {
 	ret := _mock.Called(claims)
@@ -2,6 +2,7 @@ package user
 
 import (
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -9,7 +10,8 @@ import (
 type IRepository interface {
 	GetByID(id string) (model.User, error)
 	Upsert(user *model.User) error
-	GetRecipes(userID string) (model.FoodRecipes, error)
+	GetRecipes(userID string, onlyPublished bool, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error)
+	CountRecipes(userID string, onlyPublished bool) (int64, error)
 	Update(user *model.User) error
 	GetMyFavorites(userID string) (model.FoodRecipes, error)
 }
@@ -34,14 +36,48 @@ func (repo Repository) Upsert(user *model.User) error {
 	return repo.DB.Save(user).Error
 }
 
-func (repo Repository) GetRecipes(userID string) (model.FoodRecipes, error) {
+// ownRecipes keeps the recipes of a user, only the published ones unless
+// onlyPublished is false.
+func ownRecipes(userID string, onlyPublished bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("food_recipes.user_id = ?", userID)
+		if onlyPublished {
+			db = db.Where("food_recipes.status = ?", model.RecipeStatusPublished)
+		}
+		return db
+	}
+}
+
+// GetRecipes returns the recipes of a user, newest first. Without a limit or
+// cursor every recipe is returned, as before paging was added.
+func (repo Repository) GetRecipes(userID string, onlyPublished bool, query model.PageQuery) (model.FoodRecipes, pagination.Cursors, error) {
 	var recipes model.FoodRecipes
 
-	if err := repo.DB.Preload(clause.Associations).Find(&recipes, "user_id = ?", userID).Error; err != nil {
-		return model.FoodRecipes{}, err
+	cursor, err := pagination.Decode(query.Cursor)
+	if err != nil {
+		return model.FoodRecipes{}, pagination.Cursors{}, err
 	}
 
-	return recipes, nil
+	limit := query.Size(0)
+	order := pagination.Order{Table: "food_recipes", Desc: true}
+
+	err = repo.DB.Preload(clause.Associations).
+		Scopes(ownRecipes(userID, onlyPublished), pagination.Scope(order, cursor, limit, query.Offset(limit))).
+		Find(&recipes).Error
+	if err != nil {
+		return model.FoodRecipes{}, pagination.Cursors{}, err
+	}
+
+	recipes, cursors := pagination.Paginate(recipes, limit, cursor, query.Offset(limit) > 0, func(recipe model.FoodRecipe) pagination.Cursor {
+		return pagination.Cursor{ID: recipe.ID}
+	})
+	return recipes, cursors, nil
+}
+
+func (repo Repository) CountRecipes(userID string, onlyPublished bool) (int64, error) {
+	var count int64
+	err := repo.DB.Model(&model.FoodRecipe{}).Scopes(ownRecipes(userID, onlyPublished)).Count(&count).Error
+	return count, err
 }
 
 func (repo Repository) Update(user *model.User) error {
@@ -50,7 +86,10 @@ func (repo Repository) Update(user *model.User) error {
 
 func (repo Repository) GetMyFavorites(userID string) (model.FoodRecipes, error) {
 	var recipes model.FoodRecipes
-	if err := repo.DB.Joins("JOIN favorites ON favorites.food_recipe_id = food_recipes.id").
+	// Joined tables make gorm list every field, sort_key included, unless
+	// the columns are selected
+	if err := repo.DB.Select("food_recipes.*").
+		Joins("JOIN favorites ON favorites.food_recipe_id = food_recipes.id").
 		Where("favorites.user_id = ?", userID).
 		Preload(clause.Associations).
 		Find(&recipes).Error; err != nil {
@@ -2,9 +2,9 @@ package user
 
 import (
 	"github.com/go-playground/validator/v10"
-	"github.com/klins/devpool/go-day6/wongnok/internal/helper"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model"
 	"github.com/klins/devpool/go-day6/wongnok/internal/model/dto"
+	"github.com/klins/devpool/go-day6/wongnok/internal/pagination"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -12,7 +12,7 @@ import (
 type IService interface {
 	UpsertWithClaims(claims model.Claims) (model.User, error)
 	GetByID(id string) (model.User, error)
-	GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error)
+	GetRecipes(userID string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error)
 	Update(id string, request dto.UserRequest, claims model.Claims) (model.User, error)
 	GetMyFavorites(userID string) (model.FoodRecipes, error)
 }
@@ -59,19 +59,26 @@ func (service Service) GetByID(id string) (model.User, error) {
 	return user, nil
 }
 
-func (service Service) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
+func (service Service) GetRecipes(userID string, query model.PageQuery, claims model.Claims) (model.FoodRecipes, int64, pagination.Cursors, error) {
 	if _, err := service.Repository.GetByID(claims.ID); err != nil {
-		return model.FoodRecipes{}, errors.Wrap(err, "find user")
+		return model.FoodRecipes{}, 0, pagination.Cursors{}, errors.Wrap(err, "find user")
 	}
 
-	foodRecipes, err := service.Repository.GetRecipes(userID)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return model.FoodRecipes{}, errors.Wrap(err, "get recipes")
+	// Authors see their drafts, unlisted and private recipes; others only
+	// what is published
+	onlyPublished := userID != claims.ID
+
+	total, err := service.Repository.CountRecipes(userID, onlyPublished)
+	if err != nil {
+		return model.FoodRecipes{}, 0, pagination.Cursors{}, errors.Wrap(err, "count recipes")
 	}
 
-	foodRecipes = helper.CalculateAverageRatings(foodRecipes)
+	foodRecipes, cursors, err := service.Repository.GetRecipes(userID, onlyPublished, query)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return model.FoodRecipes{}, 0, pagination.Cursors{}, errors.Wrap(err, "get recipes")
+	}
 
-	return foodRecipes, nil
+	return foodRecipes, total, cursors, nil
 }
 
 func (service Service) Update(id string, request dto.UserRequest, claims model.Claims) (model.User, error) {
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS recipe_ingredients (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        name VARCHAR(255) NOT NULL,
+        quantity NUMERIC(10, 3) NULL,
+        unit VARCHAR(50) NOT NULL DEFAULT '',
+        note TEXT NOT NULL DEFAULT '',
+        position INT NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS recipe_ingredients_food_recipe_id_idx ON recipe_ingredients (food_recipe_id, position);
+CREATE INDEX IF NOT EXISTS recipe_ingredients_name_idx ON recipe_ingredients (LOWER(name));
+
+-- Split the legacy free-text ingredient into one row per non-empty line.
+-- food_recipes.ingredient is kept as is so the original text stays readable.
+INSERT INTO recipe_ingredients (food_recipe_id, name, position, created_at, updated_at)
+SELECT
+    food_recipes.id,
+    LEFT(TRIM(lines.line), 255),
+    ROW_NUMBER() OVER (PARTITION BY food_recipes.id ORDER BY lines.ordinal),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP
+FROM food_recipes
+CROSS JOIN LATERAL REGEXP_SPLIT_TO_TABLE(food_recipes.ingredient, E'\\r?\\n') WITH ORDINALITY AS lines(line, ordinal)
+WHERE TRIM(lines.line) <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recipe_ingredients;
+-- +goose StatementEnd
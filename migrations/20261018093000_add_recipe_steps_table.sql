@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS recipe_steps (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        position INT NOT NULL,
+        text TEXT NOT NULL,
+        duration_seconds INT NULL CHECK (duration_seconds > 0),
+        image_url TEXT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS recipe_steps_food_recipe_id_idx ON recipe_steps (food_recipe_id, position);
+
+-- One step per non-empty line of the legacy instruction, which is kept as is.
+INSERT INTO recipe_steps (food_recipe_id, position, text, created_at, updated_at)
+SELECT
+    food_recipes.id,
+    ROW_NUMBER() OVER (PARTITION BY food_recipes.id ORDER BY lines.ordinal),
+    TRIM(lines.line),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP
+FROM food_recipes
+CROSS JOIN LATERAL REGEXP_SPLIT_TO_TABLE(food_recipes.instruction, E'\\r?\\n') WITH ORDINALITY AS lines(line, ordinal)
+WHERE TRIM(lines.line) <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recipe_steps;
+-- +goose StatementEnd
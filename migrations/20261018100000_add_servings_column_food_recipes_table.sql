@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS servings INT NOT NULL DEFAULT 1 CHECK (servings > 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS servings;
+-- +goose StatementEnd
@@ -0,0 +1,85 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS tag_groups (
+        id SERIAL PRIMARY KEY,
+        name VARCHAR(100) NOT NULL,
+        slug VARCHAR(100) NOT NULL UNIQUE,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE TABLE
+    IF NOT EXISTS tags (
+        id SERIAL PRIMARY KEY,
+        tag_group_id INT NOT NULL REFERENCES tag_groups ON DELETE CASCADE,
+        name VARCHAR(100) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP,
+        UNIQUE (tag_group_id, name)
+    );
+
+CREATE TABLE
+    IF NOT EXISTS recipe_tags (
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        tag_id INT NOT NULL REFERENCES tags ON DELETE CASCADE,
+        PRIMARY KEY (food_recipe_id, tag_id)
+    );
+
+CREATE INDEX IF NOT EXISTS recipe_tags_tag_id_idx ON recipe_tags (tag_id);
+
+INSERT INTO
+    tag_groups (name, slug, created_at, updated_at)
+VALUES
+    ('Cuisine', 'cuisine', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Meal type', 'meal-type', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Main ingredient', 'main-ingredient', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Region', 'region', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+INSERT INTO
+    tags (tag_group_id, name, created_at, updated_at)
+SELECT
+    tag_groups.id,
+    seed.name,
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP
+FROM
+    (
+        VALUES
+            ('cuisine', 'Thai'),
+            ('cuisine', 'Chinese'),
+            ('cuisine', 'Japanese'),
+            ('cuisine', 'Korean'),
+            ('cuisine', 'Italian'),
+            ('cuisine', 'Western'),
+            ('meal-type', 'Breakfast'),
+            ('meal-type', 'Lunch'),
+            ('meal-type', 'Dinner'),
+            ('meal-type', 'Snack'),
+            ('meal-type', 'Dessert'),
+            ('main-ingredient', 'Chicken'),
+            ('main-ingredient', 'Pork'),
+            ('main-ingredient', 'Beef'),
+            ('main-ingredient', 'Seafood'),
+            ('main-ingredient', 'Egg'),
+            ('main-ingredient', 'Vegetables'),
+            ('main-ingredient', 'Noodles'),
+            ('main-ingredient', 'Rice'),
+            ('region', 'ภาคเหนือ'),
+            ('region', 'อีสาน'),
+            ('region', 'ภาคกลาง'),
+            ('region', 'ภาคใต้')
+    ) AS seed (slug, name)
+    JOIN tag_groups ON tag_groups.slug = seed.slug;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recipe_tags;
+
+DROP TABLE IF EXISTS tags;
+
+DROP TABLE IF EXISTS tag_groups;
+-- +goose StatementEnd
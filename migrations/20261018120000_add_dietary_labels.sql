@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Labels are derived in Go from internal/dietary/rules.csv, so run
+-- `go run ./cmd/relabel` once this migration is applied.
+ALTER TABLE food_recipes
+ADD COLUMN vegetarian BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN vegan BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN halal_friendly BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN gluten_free BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN override_vegetarian BOOLEAN NULL,
+ADD COLUMN override_vegan BOOLEAN NULL,
+ADD COLUMN override_halal_friendly BOOLEAN NULL,
+ADD COLUMN override_gluten_free BOOLEAN NULL;
+
+CREATE TABLE
+    IF NOT EXISTS recipe_allergens (
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        allergen VARCHAR(30) NOT NULL,
+        detected BOOLEAN NOT NULL DEFAULT FALSE,
+        override BOOLEAN NULL,
+        PRIMARY KEY (food_recipe_id, allergen)
+    );
+
+CREATE INDEX IF NOT EXISTS recipe_allergens_allergen_idx ON recipe_allergens (allergen, food_recipe_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recipe_allergens;
+
+ALTER TABLE food_recipes
+DROP COLUMN IF EXISTS vegetarian,
+DROP COLUMN IF EXISTS vegan,
+DROP COLUMN IF EXISTS halal_friendly,
+DROP COLUMN IF EXISTS gluten_free,
+DROP COLUMN IF EXISTS override_vegetarian,
+DROP COLUMN IF EXISTS override_vegan,
+DROP COLUMN IF EXISTS override_halal_friendly,
+DROP COLUMN IF EXISTS override_gluten_free;
+-- +goose StatementEnd
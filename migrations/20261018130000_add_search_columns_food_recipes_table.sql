@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_title and search_body hold text already segmented into words by
+-- internal/search, because PostgreSQL cannot split Thai.
+ALTER TABLE food_recipes
+ADD COLUMN search_title TEXT NOT NULL DEFAULT '',
+ADD COLUMN search_body TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE food_recipes
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    SETWEIGHT(TO_TSVECTOR('simple', search_title), 'A') || SETWEIGHT(TO_TSVECTOR('simple', search_body), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS food_recipes_search_vector_idx ON food_recipes USING GIN (search_vector);
+
+-- Good enough for Latin text until `go run ./cmd/relabel` segments the Thai.
+UPDATE food_recipes
+SET
+    search_title = LOWER(name),
+    search_body = LOWER(CONCAT_WS(' ', description, ingredient, instruction));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS food_recipes_search_vector_idx;
+
+ALTER TABLE food_recipes
+DROP COLUMN IF EXISTS search_vector,
+DROP COLUMN IF EXISTS search_title,
+DROP COLUMN IF EXISTS search_body;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Revisions are never updated or deleted on their own, so there is no
+-- updated_at or deleted_at. Recipes created earlier get their first revision
+-- when they are next edited.
+CREATE TABLE
+    IF NOT EXISTS recipe_revisions (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        number INT NOT NULL CHECK (number > 0),
+        user_id VARCHAR(100) NOT NULL,
+        snapshot JSONB NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        UNIQUE (food_recipe_id, number)
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recipe_revisions;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Recipes that already exist stay public. New ones start as drafts.
+ALTER TABLE food_recipes
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'published'
+    CHECK (status IN ('draft', 'published', 'unlisted', 'private'));
+
+ALTER TABLE food_recipes ALTER COLUMN status SET DEFAULT 'draft';
+
+CREATE INDEX IF NOT EXISTS food_recipes_status_idx ON food_recipes (status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS forked_from_id INT NULL REFERENCES food_recipes ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS food_recipes_forked_from_id_idx ON food_recipes (forked_from_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS forked_from_id;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS collections (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        title VARCHAR(255) NOT NULL,
+        description TEXT NOT NULL DEFAULT '',
+        cover_image_url TEXT NULL,
+        visibility VARCHAR(20) NOT NULL DEFAULT 'private' CHECK (visibility IN ('public', 'private')),
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS collections_user_id_idx ON collections (user_id);
+
+CREATE TABLE
+    IF NOT EXISTS collection_recipes (
+        collection_id INT NOT NULL REFERENCES collections ON DELETE CASCADE,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        position INT NOT NULL,
+        PRIMARY KEY (collection_id, food_recipe_id)
+    );
+
+CREATE INDEX IF NOT EXISTS collection_recipes_position_idx ON collection_recipes (collection_id, position);
+
+CREATE INDEX IF NOT EXISTS collection_recipes_food_recipe_id_idx ON collection_recipes (food_recipe_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS collection_recipes;
+
+DROP TABLE IF EXISTS collections;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS meal_plan_entries (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        date DATE NOT NULL,
+        slot VARCHAR(20) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        servings INT NULL CHECK (servings > 0),
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        UNIQUE (user_id, date, slot, food_recipe_id)
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meal_plan_entries;
+-- +goose StatementEnd
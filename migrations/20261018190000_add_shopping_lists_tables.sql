@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS shopping_lists (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        name VARCHAR(255) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS shopping_lists_user_id_idx ON shopping_lists (user_id);
+
+CREATE TABLE
+    IF NOT EXISTS shopping_list_items (
+        id SERIAL PRIMARY KEY,
+        shopping_list_id INT NOT NULL REFERENCES shopping_lists ON DELETE CASCADE,
+        name VARCHAR(255) NOT NULL,
+        quantity NUMERIC(10, 3) NULL,
+        unit VARCHAR(50) NOT NULL DEFAULT '',
+        aisle VARCHAR(50) NOT NULL,
+        checked BOOLEAN NOT NULL DEFAULT FALSE,
+        position INT NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL
+    );
+
+CREATE INDEX IF NOT EXISTS shopping_list_items_shopping_list_id_idx ON shopping_list_items (shopping_list_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS shopping_list_items;
+
+DROP TABLE IF EXISTS shopping_lists;
+-- +goose StatementEnd
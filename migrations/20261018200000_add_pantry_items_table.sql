@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS pantry_items (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        name VARCHAR(255) NOT NULL,
+        quantity NUMERIC(10, 3) NULL,
+        unit VARCHAR(50) NOT NULL DEFAULT '',
+        expires_on DATE NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS pantry_items_user_id_name_idx ON pantry_items (user_id, LOWER(name));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pantry_items;
+-- +goose StatementEnd
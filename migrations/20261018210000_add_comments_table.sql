@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    IF NOT EXISTS comments (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        parent_id INT NULL REFERENCES comments,
+        body TEXT NOT NULL,
+        rating_id INT NULL REFERENCES ratings,
+        edited_at TIMESTAMP NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS comments_food_recipe_id_idx ON comments (food_recipe_id, id) WHERE parent_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS comments_parent_id_idx ON comments (parent_id, id);
+
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS comment_count INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS comment_count;
+
+DROP TABLE IF EXISTS comments;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keep the latest rating of each user on a recipe, preferring one that was
+-- not deleted, and point reviews at it
+CREATE TEMPORARY TABLE ranked_ratings AS
+SELECT
+    id,
+    FIRST_VALUE(id) OVER (
+        PARTITION BY food_recipe_id, user_id
+        ORDER BY deleted_at IS NULL DESC, updated_at DESC, id DESC
+    ) AS kept_id
+FROM
+    ratings;
+
+UPDATE comments
+SET rating_id = ranked_ratings.kept_id
+FROM ranked_ratings
+WHERE comments.rating_id = ranked_ratings.id AND ranked_ratings.id <> ranked_ratings.kept_id;
+
+DELETE FROM ratings USING ranked_ratings
+WHERE ratings.id = ranked_ratings.id AND ranked_ratings.id <> ranked_ratings.kept_id;
+
+DROP TABLE ranked_ratings;
+
+UPDATE ratings SET score = LEAST(GREATEST(score, 1), 5);
+
+ALTER TABLE ratings ALTER COLUMN score TYPE NUMERIC(2, 1);
+
+ALTER TABLE ratings ADD CONSTRAINT ratings_score_check CHECK (score BETWEEN 1 AND 5);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ratings_food_recipe_id_user_id_idx ON ratings (food_recipe_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS ratings_food_recipe_id_user_id_idx;
+
+ALTER TABLE ratings DROP CONSTRAINT IF EXISTS ratings_score_check;
+
+ALTER TABLE ratings ALTER COLUMN score TYPE INT USING ROUND(score);
+-- +goose StatementEnd
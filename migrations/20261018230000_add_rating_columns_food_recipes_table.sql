@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rating_score is a Bayesian average: every recipe starts as if rated 10 times
+-- with 3 stars, so a few ratings move it less than many do
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS rating_count INT NOT NULL DEFAULT 0;
+
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS average_rating DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS rating_score DOUBLE PRECISION GENERATED ALWAYS AS ((rating_count * average_rating + 10 * 3) / (rating_count + 10)) STORED;
+
+UPDATE food_recipes
+SET
+    rating_count = summary.count,
+    average_rating = summary.average
+FROM
+    (
+        SELECT
+            food_recipe_id,
+            COUNT(*) AS count,
+            AVG(score)::DOUBLE PRECISION AS average
+        FROM
+            ratings
+        WHERE
+            deleted_at IS NULL
+        GROUP BY
+            food_recipe_id
+    ) AS summary
+WHERE
+    food_recipes.id = summary.food_recipe_id;
+
+CREATE INDEX IF NOT EXISTS food_recipes_rating_score_idx ON food_recipes (rating_score, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS rating_score;
+
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS average_rating;
+
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS rating_count;
+-- +goose StatementEnd
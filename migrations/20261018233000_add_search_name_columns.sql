@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_name holds the name already split into words by internal/search, so
+-- pantry items match ingredients word by word.
+ALTER TABLE recipe_ingredients
+ADD COLUMN search_name TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE pantry_items
+ADD COLUMN search_name TEXT NOT NULL DEFAULT '';
+
+-- Good enough for Latin text until `go run ./cmd/relabel` segments the Thai.
+UPDATE recipe_ingredients
+SET
+    search_name = TRIM(REGEXP_REPLACE(LOWER(name), '[[:space:][:punct:]]+', ' ', 'g'));
+
+UPDATE pantry_items
+SET
+    search_name = TRIM(REGEXP_REPLACE(LOWER(name), '[[:space:][:punct:]]+', ' ', 'g'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pantry_items
+DROP COLUMN IF EXISTS search_name;
+
+ALTER TABLE recipe_ingredients
+DROP COLUMN IF EXISTS search_name;
+-- +goose StatementEnd
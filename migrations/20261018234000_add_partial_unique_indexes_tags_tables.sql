@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Only live tags and groups are unique, so a deleted one can be created again
+ALTER TABLE tag_groups DROP CONSTRAINT IF EXISTS tag_groups_slug_key;
+
+ALTER TABLE tags DROP CONSTRAINT IF EXISTS tags_tag_group_id_name_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS tag_groups_slug_idx ON tag_groups (slug) WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS tags_tag_group_id_name_idx ON tags (tag_group_id, name) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS tags_tag_group_id_name_idx;
+
+DROP INDEX IF EXISTS tag_groups_slug_idx;
+
+ALTER TABLE tags ADD CONSTRAINT tags_tag_group_id_name_key UNIQUE (tag_group_id, name);
+
+ALTER TABLE tag_groups ADD CONSTRAINT tag_groups_slug_key UNIQUE (slug);
+-- +goose StatementEnd
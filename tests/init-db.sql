@@ -64,9 +64,29 @@ CREATE TABLE
         ingredient TEXT NOT NULL,
         instruction TEXT NOT NULL,
         image_url TEXT NULL,
+        servings INT NOT NULL DEFAULT 1 CHECK (servings > 0),
+        status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published', 'unlisted', 'private')),
+        vegetarian BOOLEAN NOT NULL DEFAULT FALSE,
+        vegan BOOLEAN NOT NULL DEFAULT FALSE,
+        halal_friendly BOOLEAN NOT NULL DEFAULT FALSE,
+        gluten_free BOOLEAN NOT NULL DEFAULT FALSE,
+        override_vegetarian BOOLEAN NULL,
+        override_vegan BOOLEAN NULL,
+        override_halal_friendly BOOLEAN NULL,
+        override_gluten_free BOOLEAN NULL,
+        search_title TEXT NOT NULL DEFAULT '',
+        search_body TEXT NOT NULL DEFAULT '',
+        search_vector TSVECTOR GENERATED ALWAYS AS (
+            SETWEIGHT(TO_TSVECTOR('simple', search_title), 'A') || SETWEIGHT(TO_TSVECTOR('simple', search_body), 'B')
+        ) STORED,
         cooking_duration_id INT NOT NULL REFERENCES cooking_durations,
         difficulty_id INT NOT NULL REFERENCES difficulties,
         user_id VARCHAR(100) REFERENCES users, --//new
+        forked_from_id INT NULL REFERENCES food_recipes ON DELETE SET NULL,
+        comment_count INT NOT NULL DEFAULT 0,
+        rating_count INT NOT NULL DEFAULT 0,
+        average_rating DOUBLE PRECISION NOT NULL DEFAULT 0,
+        rating_score DOUBLE PRECISION GENERATED ALWAYS AS ((rating_count * average_rating + 10 * 3) / (rating_count + 10)) STORED,
         created_at TIMESTAMP NOT NULL,
         updated_at TIMESTAMP NOT NULL,
         deleted_at TIMESTAMP
@@ -78,6 +98,7 @@ INSERT INTO
         description,
         ingredient,
         instruction,
+        status,
         cooking_duration_id,
         difficulty_id,
         created_at,
@@ -89,24 +110,146 @@ VALUES
         'Eggs fried?',
         'Eggs',
         'Cooking',
+        'published',
         1,
         1,
         CURRENT_TIMESTAMP,
         CURRENT_TIMESTAMP
     );
 
+-- recipe_ingredients table
+CREATE TABLE
+    IF NOT EXISTS recipe_ingredients (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        name VARCHAR(255) NOT NULL,
+        quantity NUMERIC(10, 3) NULL,
+        unit VARCHAR(50) NOT NULL DEFAULT '',
+        note TEXT NOT NULL DEFAULT '',
+        position INT NOT NULL,
+        search_name TEXT NOT NULL DEFAULT '',
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+INSERT INTO
+    recipe_ingredients (
+        food_recipe_id,
+        name,
+        position,
+        search_name,
+        created_at,
+        updated_at
+    )
+VALUES
+    (1, 'Eggs', 1, 'egg', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+-- recipe_steps table
+CREATE TABLE
+    IF NOT EXISTS recipe_steps (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        position INT NOT NULL,
+        text TEXT NOT NULL,
+        duration_seconds INT NULL CHECK (duration_seconds > 0),
+        image_url TEXT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+INSERT INTO
+    recipe_steps (
+        food_recipe_id,
+        position,
+        text,
+        created_at,
+        updated_at
+    )
+VALUES
+    (1, 1, 'Cooking', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+-- recipe_revisions table
+CREATE TABLE
+    IF NOT EXISTS recipe_revisions (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        number INT NOT NULL CHECK (number > 0),
+        user_id VARCHAR(100) NOT NULL,
+        snapshot JSONB NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        UNIQUE (food_recipe_id, number)
+    );
+
+-- recipe_allergens table
+CREATE TABLE
+    IF NOT EXISTS recipe_allergens (
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        allergen VARCHAR(30) NOT NULL,
+        detected BOOLEAN NOT NULL DEFAULT FALSE,
+        override BOOLEAN NULL,
+        PRIMARY KEY (food_recipe_id, allergen)
+    );
+
+-- tag_groups table
+CREATE TABLE
+    IF NOT EXISTS tag_groups (
+        id SERIAL PRIMARY KEY,
+        name VARCHAR(100) NOT NULL,
+        slug VARCHAR(100) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS tag_groups_slug_idx ON tag_groups (slug) WHERE deleted_at IS NULL;
+
+INSERT INTO
+    tag_groups (name, slug, created_at, updated_at)
+VALUES
+    ('Region', 'region', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+-- tags table
+CREATE TABLE
+    IF NOT EXISTS tags (
+        id SERIAL PRIMARY KEY,
+        tag_group_id INT NOT NULL REFERENCES tag_groups ON DELETE CASCADE,
+        name VARCHAR(100) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS tags_tag_group_id_name_idx ON tags (tag_group_id, name) WHERE deleted_at IS NULL;
+
+INSERT INTO
+    tags (tag_group_id, name, created_at, updated_at)
+VALUES
+    (1, 'อีสาน', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+-- recipe_tags table
+CREATE TABLE
+    IF NOT EXISTS recipe_tags (
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        tag_id INT NOT NULL REFERENCES tags ON DELETE CASCADE,
+        PRIMARY KEY (food_recipe_id, tag_id)
+    );
+
 -- ratings table
 CREATE TABLE
     IF NOT EXISTS ratings (
         id SERIAL PRIMARY KEY,
         food_recipe_id INT NOT NULL REFERENCES food_recipes,
-        score INT NOT NULL,
+        score NUMERIC(2, 1) NOT NULL CHECK (score BETWEEN 1 AND 5),
         user_id VARCHAR(100) NOT NULL REFERENCES users,
         created_at TIMESTAMP NOT NULL,
         updated_at TIMESTAMP NOT NULL,
         deleted_at TIMESTAMP
     );
 
+CREATE UNIQUE INDEX IF NOT EXISTS ratings_food_recipe_id_user_id_idx ON ratings (food_recipe_id, user_id);
+
 INSERT INTO
     ratings (
         food_recipe_id,
@@ -122,11 +265,103 @@ VALUES
         '38fa4e9e-27de-42d5-a70f-9f01d41f32c2',
         CURRENT_TIMESTAMP,
         CURRENT_TIMESTAMP
-    ),
-    (
-        1,
-        3,
-        '38fa4e9e-27de-42d5-a70f-9f01d41f32c2',
-        CURRENT_TIMESTAMP,
-        CURRENT_TIMESTAMP
-    );
\ No newline at end of file
+    );
+
+UPDATE food_recipes
+SET
+    rating_count = 1,
+    average_rating = 5
+WHERE
+    id = 1;
+
+-- collections table
+CREATE TABLE
+    IF NOT EXISTS collections (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        title VARCHAR(255) NOT NULL,
+        description TEXT NOT NULL DEFAULT '',
+        cover_image_url TEXT NULL,
+        visibility VARCHAR(20) NOT NULL DEFAULT 'private' CHECK (visibility IN ('public', 'private')),
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+-- collection_recipes table
+CREATE TABLE
+    IF NOT EXISTS collection_recipes (
+        collection_id INT NOT NULL REFERENCES collections ON DELETE CASCADE,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        position INT NOT NULL,
+        PRIMARY KEY (collection_id, food_recipe_id)
+    );
+
+-- meal_plan_entries table
+CREATE TABLE
+    IF NOT EXISTS meal_plan_entries (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        date DATE NOT NULL,
+        slot VARCHAR(20) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        servings INT NULL CHECK (servings > 0),
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        UNIQUE (user_id, date, slot, food_recipe_id)
+    );
+
+-- shopping_lists table
+CREATE TABLE
+    IF NOT EXISTS shopping_lists (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        name VARCHAR(255) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+-- shopping_list_items table
+CREATE TABLE
+    IF NOT EXISTS shopping_list_items (
+        id SERIAL PRIMARY KEY,
+        shopping_list_id INT NOT NULL REFERENCES shopping_lists ON DELETE CASCADE,
+        name VARCHAR(255) NOT NULL,
+        quantity NUMERIC(10, 3) NULL,
+        unit VARCHAR(50) NOT NULL DEFAULT '',
+        aisle VARCHAR(50) NOT NULL,
+        checked BOOLEAN NOT NULL DEFAULT FALSE,
+        position INT NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL
+    );
+
+-- pantry_items table
+CREATE TABLE
+    IF NOT EXISTS pantry_items (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        name VARCHAR(255) NOT NULL,
+        search_name TEXT NOT NULL DEFAULT '',
+        quantity NUMERIC(10, 3) NULL,
+        unit VARCHAR(50) NOT NULL DEFAULT '',
+        expires_on DATE NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL
+    );
+
+-- comments table
+CREATE TABLE
+    IF NOT EXISTS comments (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        parent_id INT NULL REFERENCES comments,
+        body TEXT NOT NULL,
+        rating_id INT NULL REFERENCES ratings,
+        edited_at TIMESTAMP NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );